@@ -0,0 +1,135 @@
+package govalidate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+// ErrBudgetExceeded 错误记录数超出上限，剩余记录未校验
+var ErrBudgetExceeded = errors.New("govalidate: error budget exceeded")
+
+// BudgetError 错误记录数超出上限，序号不小于Skipped的记录均未校验，errors.Is(err, ErrBudgetExceeded)为true
+type BudgetError struct {
+	Skipped int
+}
+
+// Error 错误信息
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("%v, records from %d skipped", ErrBudgetExceeded, e.Skipped)
+}
+
+// Unwrap 返回ErrBudgetExceeded
+func (e *BudgetError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// RecordError 批量校验中单条记录的错误
+type RecordError struct {
+	Index int
+	Field string
+	Msg   string
+	Code  int32
+}
+
+// Error 错误信息
+func (e RecordError) Error() string {
+	return fmt.Sprintf("record %d, field %s: %s", e.Index, e.Field, e.Msg)
+}
+
+// ValidateBatch 使用默认引擎并发校验多条记录
+func ValidateBatch(ctx context.Context, records []map[string]interface{}, rules []validator.Filter, workers int, budget ...int) ([]map[string]interface{}, []RecordError, error) {
+	return defaultEngine.ValidateBatch(ctx, records, rules, workers, budget...)
+}
+
+// ValidateBatch 并发校验多条记录
+// 返回结果与records一一对应，校验失败或未校验的记录对应位置为nil
+// 错误列表按记录序号升序排列
+// workers 并发数，小于等于0时取CPU核数
+// budget 可选，错误记录数的上限，出现第budget+1条错误记录后停止校验剩余记录，并返回*BudgetError
+// 超出上限时错误列表为按记录顺序的前budget条错误，与并发数无关；第budget+1条错误记录及其后的记录均视为未校验，结果为nil
+func (e *Engine) ValidateBatch(ctx context.Context, records []map[string]interface{}, rules []validator.Filter, workers int, budget ...int) ([]map[string]interface{}, []RecordError, error) {
+	maxErrs := 0
+	if len(budget) > 0 && budget[0] > 0 {
+		maxErrs = budget[0]
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(records) {
+		workers = len(records)
+	}
+
+	results := make([]map[string]interface{}, len(records))
+	errs := make([]RecordError, 0)
+
+	// 超出上限后停止分发，已分发的记录继续校验，保证截止位置之前的记录均已校验
+	feedCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				res, code, err := e.Validate1(ctx, records[idx], rules)
+				if err == nil {
+					results[idx] = res
+					continue
+				}
+				rerr := RecordError{
+					Index: idx,
+					Msg:   err.Error(),
+					Code:  code,
+				}
+				var ferr *validator.FieldError
+				if errors.As(err, &ferr) {
+					rerr.Field = ferr.Field
+				}
+				mu.Lock()
+				errs = append(errs, rerr)
+				if maxErrs > 0 && len(errs) > maxErrs {
+					stop()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for idx := range records {
+		select {
+		case jobs <- idx:
+		case <-feedCtx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Index < errs[j].Index
+	})
+	if ctx.Err() != nil {
+		return results, errs, ctx.Err()
+	}
+	if maxErrs > 0 && len(errs) > maxErrs {
+		skipped := errs[maxErrs].Index
+		for idx := skipped; idx < len(results); idx++ {
+			results[idx] = nil
+		}
+		return results, errs[:maxErrs], &BudgetError{Skipped: skipped}
+	}
+	return results, errs, nil
+}
@@ -0,0 +1,64 @@
+package govalidate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+func TestValidateBatch(t *testing.T) {
+	records := make([]map[string]interface{}, 0)
+	for i := 0; i < 100; i++ {
+		age := interface{}(i)
+		if i%10 == 3 {
+			age = "x"
+		}
+		records = append(records, map[string]interface{}{"age": age})
+	}
+	rules := []validator.Filter{
+		NewFilter("age", []validator.Validator{validator.Required(), validator.Int("年龄错误")}, "", "10001"),
+	}
+
+	res, errs, err := ValidateBatch(context.Background(), records, rules, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 10 {
+		t.Fatalf("error count: %d", len(errs))
+	}
+	for k, e := range errs {
+		if e.Index != k*10+3 || e.Field != "age" || e.Msg != "年龄错误" || e.Code != 10001 {
+			t.Fatalf("record error: %+v", e)
+		}
+		if res[e.Index] != nil {
+			t.Fatalf("record %d should be rejected", e.Index)
+		}
+	}
+	if age, ok := res[99]["age"].(int); !ok || age != 99 {
+		t.Fatal("order not preserved")
+	}
+
+	// 错误预算，多个worker时结果同样按记录顺序确定
+	for i := 0; i < 20; i++ {
+		res, errs, err = ValidateBatch(context.Background(), records, rules, 8, 2)
+		var berr *BudgetError
+		if !errors.Is(err, ErrBudgetExceeded) || !errors.As(err, &berr) || berr.Skipped != 23 {
+			t.Fatalf("budget: %v", err)
+		}
+		if len(errs) != 2 || errs[0].Index != 3 || errs[1].Index != 13 {
+			t.Fatalf("budget errors: %+v", errs)
+		}
+		if res[22] == nil || res[23] != nil || res[99] != nil {
+			t.Fatal("records after the budget should be skipped")
+		}
+	}
+
+	// 错误数等于预算且全部记录已校验
+	tail := []map[string]interface{}{{"age": 1}, {"age": "x"}, {"age": 2}, {"age": "y"}}
+	res, errs, err = ValidateBatch(context.Background(), tail, rules, 1, 2)
+	if err != nil || len(errs) != 2 || res[2] == nil {
+		t.Fatal(err, errs)
+	}
+}
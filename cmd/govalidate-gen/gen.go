@@ -0,0 +1,660 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// kindInfo 字段值类型的转换方式，与validator包的类型规则一致
+type kindInfo struct {
+	basic types.BasicKind
+	// rule 类型规则名称
+	rule string
+	// convert 转换函数
+	convert string
+	// fail 转换失败的条件
+	fail string
+	// msg 转换失败时FailMsg的参数
+	msg string
+}
+
+var kinds = map[types.BasicKind]kindInfo{
+	types.String:  {types.String, "string", "g.String(v)", "!ok || len(val) == 0", `"string", nil`},
+	types.Bool:    {types.Bool, "boolean", "g.Boolean(v)", "!ok", `"boolean", nil`},
+	types.Int:     {types.Int, "int", "g.Int(v)", "!ok", `"int", nil`},
+	types.Int32:   {types.Int32, "int32", "g.Int32(v)", "!ok", `"int_range", map[string]interface{}{"min": -2147483648, "max": 2147483647}`},
+	types.Int64:   {types.Int64, "int64", "g.Int64(v)", "!ok", `"int_range", map[string]interface{}{"min": int64(-9223372036854775808), "max": int64(9223372036854775807)}`},
+	types.Uint32:  {types.Uint32, "uint32", "g.Uint32(v)", "!ok", `"int_range", map[string]interface{}{"min": 0, "max": uint32(4294967295)}`},
+	types.Uint64:  {types.Uint64, "uint64", "g.Uint64(v)", "!ok", `"int_range", map[string]interface{}{"min": 0, "max": uint64(18446744073709551615)}`},
+	types.Float64: {types.Float64, "float", "g.Float(v)", "!ok || !executor.Finite(val)", `"float", nil`},
+}
+
+// stringChecks 无参数的字符串规则及对应的executor函数
+var stringChecks = map[string]string{
+	"email":    "Email",
+	"url":      "Url",
+	"phone":    "Phone",
+	"ipv4":     "Ipv4",
+	"date":     "Date",
+	"datetime": "Datetime",
+	"rfc3339":  "DatetimeRFC3339",
+	"dotint":   "DotInt",
+}
+
+// tagRule 标签中的单条规则
+type tagRule struct {
+	name string
+	args []string
+}
+
+// generator 生成单个包的校验代码
+type generator struct {
+	pkg     *types.Package
+	tag     string
+	structs map[string]bool
+	imports map[string]bool
+	vars    bytes.Buffer
+	funcs   bytes.Buffer
+}
+
+// generate 生成names中结构体的校验代码，names为空时处理包含校验标签的全部结构体
+func generate(pkg *types.Package, names []string, tag string) ([]byte, error) {
+	g := &generator{
+		pkg:     pkg,
+		tag:     tag,
+		structs: make(map[string]bool),
+		imports: map[string]bool{"context": true, "github.com/rumis/govalidate/validator": true},
+	}
+	if len(names) == 0 {
+		for _, name := range pkg.Scope().Names() {
+			if st, ok := g.lookup(name); ok && g.tagged(st) {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("govalidate-gen: no struct with %s tags in package %s", tag, pkg.Name())
+		}
+	}
+	for _, name := range names {
+		if _, ok := g.lookup(name); !ok {
+			return nil, fmt.Errorf("govalidate-gen: struct %s not found in package %s", name, pkg.Name())
+		}
+		g.structs[name] = true
+	}
+	for _, name := range names {
+		st, _ := g.lookup(name)
+		if err := g.genStruct(name, st); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s\n\npackage %s\n\nimport (\n", generatedHeader, pkg.Name())
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for idx, path := range paths {
+		// 标准库与其他包分组
+		if idx > 0 && !strings.Contains(paths[idx-1], ".") && strings.Contains(path, ".") {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+	if g.vars.Len() > 0 {
+		fmt.Fprintf(&buf, "var (\n%s)\n\n", g.vars.String())
+	}
+	buf.Write(g.funcs.Bytes())
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("govalidate-gen: format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// lookup 查找包中的结构体
+func (g *generator) lookup(name string) (*types.Struct, bool) {
+	obj, ok := g.pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, false
+	}
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	return st, ok
+}
+
+// tagged 结构体是否包含校验标签
+func (g *generator) tagged(st *types.Struct) bool {
+	for idx := 0; idx < st.NumFields(); idx++ {
+		if _, ok := reflect.StructTag(st.Tag(idx)).Lookup(g.tag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// genStruct 生成结构体的ValidateParams方法
+func (g *generator) genStruct(name string, st *types.Struct) error {
+	var calls bytes.Buffer
+	for idx := 0; idx < st.NumFields(); idx++ {
+		field := st.Field(idx)
+		tags := reflect.StructTag(st.Tag(idx))
+		rules, ok := tags.Lookup(g.tag)
+		if !ok || rules == "-" {
+			continue
+		}
+		fg := &fieldGen{
+			g:      g,
+			field:  field,
+			key:    paramKey(field.Name(), tags),
+			prefix: "_" + name + "_" + field.Name(),
+			owner:  name,
+		}
+		if err := fg.gen(parseTag(rules), tags); err != nil {
+			return fmt.Errorf("govalidate-gen: %s.%s: %w", name, field.Name(), err)
+		}
+		fmt.Fprintf(&calls, "if ferr := %s(g, dst, params[%q]); ferr != nil {\nreturn ferr\n}\n", fg.prefix, fg.key)
+	}
+	fmt.Fprintf(&g.funcs, "// ValidateParams 校验参数并填充%s，遇到首个错误时中断\n", name)
+	fmt.Fprintf(&g.funcs, "func (dst *%s) ValidateParams(ctx context.Context, params map[string]interface{}) *validator.FieldError {\n", name)
+	fmt.Fprintf(&g.funcs, "g := validator.NewGenContext(ctx)\n%sreturn nil\n}\n\n", calls.String())
+	return nil
+}
+
+// paramKey 参数KEY，取json标签，未设置时为字段名
+func paramKey(field string, tags reflect.StructTag) string {
+	if name := strings.Split(tags.Get("json"), ",")[0]; name != "" && name != "-" {
+		return name
+	}
+	return field
+}
+
+// parseTag 解析分号分隔的规则，regex的参数为剩余的全部内容
+func parseTag(tag string) []tagRule {
+	var rules []tagRule
+	for tag != "" {
+		item := tag
+		tag = ""
+		if idx := strings.Index(item, ";"); idx >= 0 {
+			item, tag = item[:idx], item[idx+1:]
+		}
+		name, args := item, ""
+		if idx := strings.Index(item, ":"); idx >= 0 {
+			name, args = item[:idx], item[idx+1:]
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch {
+		case name == "":
+			continue
+		case name == "regex":
+			if tag != "" {
+				args, tag = args+";"+tag, ""
+			}
+			rules = append(rules, tagRule{name: name, args: []string{args}})
+			continue
+		}
+		rule := tagRule{name: name}
+		if args != "" {
+			for _, arg := range strings.Split(args, ",") {
+				rule.args = append(rule.args, strings.TrimSpace(arg))
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// fieldGen 生成单个字段的校验函数
+type fieldGen struct {
+	g         *generator
+	field     *types.Var
+	key       string
+	prefix    string
+	owner     string
+	kind      kindInfo
+	nested    bool
+	converted bool
+	// implicit 未声明类型规则，转换失败时报告其后首条规则的错误，与运行时规则链一致
+	implicit bool
+	nvars    int
+	body     bytes.Buffer
+}
+
+// gen 生成字段的校验函数及Filter
+func (fg *fieldGen) gen(rules []tagRule, tags reflect.StructTag) error {
+	if err := fg.resolveKind(); err != nil {
+		return err
+	}
+	code := int64(0)
+	if str := tags.Get("code"); str != "" {
+		var err error
+		if code, err = strconv.ParseInt(str, 10, 32); err != nil {
+			return fmt.Errorf("invalid code %q", str)
+		}
+	}
+	filter := fmt.Sprintf("validator.NewNormalFilter(%q, nil, %q, %d)", fg.key, tags.Get("msg"), code)
+	if label := tags.Get("label"); label != "" {
+		filter += fmt.Sprintf(".WithLabel(%q)", label)
+	}
+	fmt.Fprintf(&fg.g.vars, "%s_filter = %s\n", fg.prefix, filter)
+
+	for _, rule := range rules {
+		if err := fg.rule(rule); err != nil {
+			return err
+		}
+	}
+	if !fg.converted {
+		if err := fg.convert(nil); err != nil {
+			return err
+		}
+	}
+	if !fg.nested {
+		typ := types.TypeString(fg.field.Type(), types.RelativeTo(fg.g.pkg))
+		if types.Identical(fg.field.Type(), types.Typ[fg.kind.basic]) {
+			fmt.Fprintf(&fg.body, "dst.%s = val\n", fg.field.Name())
+		} else {
+			fmt.Fprintf(&fg.body, "dst.%s = %s(val)\n", fg.field.Name(), typ)
+		}
+	}
+
+	w := &fg.g.funcs
+	fmt.Fprintf(w, "// %s 校验参数%s并填充%s.%s\n", fg.prefix, fg.key, fg.owner, fg.field.Name())
+	fmt.Fprintf(w, "func %s(g validator.GenContext, dst *%s, v interface{}) *validator.FieldError {\n", fg.prefix, fg.owner)
+	fmt.Fprintf(w, "%sreturn nil\n}\n\n", fg.body.String())
+	return nil
+}
+
+// resolveKind 根据字段类型确定转换方式
+func (fg *fieldGen) resolveKind() error {
+	typ := fg.field.Type()
+	if named, ok := typ.(*types.Named); ok && named.Obj().Pkg() == fg.g.pkg {
+		if _, ok := named.Underlying().(*types.Struct); ok {
+			if !fg.g.structs[named.Obj().Name()] {
+				return fmt.Errorf("struct %s has no generated validator, add it to -type", named.Obj().Name())
+			}
+			fg.nested = true
+			return nil
+		}
+	}
+	if basic, ok := typ.Underlying().(*types.Basic); ok {
+		if info, ok := kinds[basic.Kind()]; ok {
+			fg.kind = info
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported field type %s", types.TypeString(typ, types.RelativeTo(fg.g.pkg)))
+}
+
+// fail 生成校验失败的代码
+func (fg *fieldGen) fail(cond string, value string, msg string) {
+	if fg.implicit {
+		cond = "!ok || " + cond
+	}
+	fmt.Fprintf(&fg.body, "if %s {\nreturn g.Fail(%s_filter, %s, validator.FailMsg(nil, %s))\n}\n", cond, fg.prefix, value, msg)
+}
+
+// newVar 定义包级变量，返回变量名
+func (fg *fieldGen) newVar(expr string) string {
+	fg.nvars++
+	name := fmt.Sprintf("%s_%d", fg.prefix, fg.nvars)
+	fmt.Fprintf(&fg.g.vars, "%s = %s\n", name, expr)
+	return name
+}
+
+// rule 生成单条规则的代码
+func (fg *fieldGen) rule(rule tagRule) error {
+	switch rule.name {
+	case "required", "optional", "omitempty", "emptystring":
+		if fg.converted {
+			return fmt.Errorf("rule %s must precede type conversion rules", rule.name)
+		}
+		return fg.presence(rule)
+	}
+	if fg.nested {
+		return fmt.Errorf("rule %s not supported on struct field", rule.name)
+	}
+	if rule.name == fg.kind.rule {
+		if fg.converted {
+			return fmt.Errorf("duplicate type rule %s", rule.name)
+		}
+		return fg.convert(rule.args)
+	}
+	if _, ok := ruleKinds[rule.name]; ok {
+		return fmt.Errorf("rule %s does not match field type %s", rule.name, fg.field.Type())
+	}
+	if !fg.converted {
+		fg.convertImplicit()
+		defer func() { fg.implicit = false }()
+	}
+	return fg.check(rule)
+}
+
+// ruleKinds 类型规则
+var ruleKinds = map[string]bool{
+	"string": true, "boolean": true, "int": true, "int32": true, "int64": true,
+	"uint32": true, "uint64": true, "float": true,
+}
+
+// presence 参数是否存在的规则，同Required、Optional、OmitEmpty、EmptyString
+func (fg *fieldGen) presence(rule tagRule) error {
+	switch rule.name {
+	case "required":
+		fg.fail("v == nil", "v", `"required", nil`)
+	case "optional":
+		fg.g.imports["github.com/rumis/govalidate/executor"] = true
+		if len(rule.args) == 0 {
+			fmt.Fprintf(&fg.body, "if executor.IsNil(v) {\nreturn nil\n}\n")
+		} else {
+			def, err := fg.literal(rule.args)
+			if err != nil {
+				return fmt.Errorf("rule optional: %v", err)
+			}
+			fmt.Fprintf(&fg.body, "if executor.IsNil(v) {\nv = %s\n}\n", def)
+		}
+	case "omitempty":
+		fg.g.imports["github.com/rumis/govalidate/executor"] = true
+		fmt.Fprintf(&fg.body, "if executor.IsNil(v) {\nreturn nil\n}\n")
+	case "emptystring":
+		if fg.nested || fg.kind.basic != types.String {
+			return fmt.Errorf("rule emptystring requires a string field")
+		}
+		fg.g.imports["github.com/rumis/govalidate/utils"] = true
+		fmt.Fprintf(&fg.body, "if str, ok := utils.GetStringValue(v); ok && len(str) == 0 {\nreturn nil\n} else {\nv = str\n}\n")
+	}
+	return nil
+}
+
+// literal 默认值的Go字面量，类型与字段的转换结果一致，严格类型模式下同样可以通过转换
+func (fg *fieldGen) literal(args []string) (string, error) {
+	if fg.nested {
+		return "", fmt.Errorf("default value not supported on struct field")
+	}
+	str := strings.Join(args, ",")
+	if fg.kind.basic == types.String {
+		return strconv.Quote(str), nil
+	}
+	if len(args) != 1 {
+		return "", fmt.Errorf("expect 1 default value, got %d", len(args))
+	}
+	name := types.Typ[fg.kind.basic].Name()
+	switch fg.kind.basic {
+	case types.Bool:
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s default %q", name, str)
+		}
+		return strconv.FormatBool(b), nil
+	case types.Int, types.Int32, types.Int64:
+		v, err := strconv.ParseInt(str, 10, map[types.BasicKind]int{types.Int: 0, types.Int32: 32, types.Int64: 64}[fg.kind.basic])
+		if err != nil {
+			return "", fmt.Errorf("invalid %s default %q", name, str)
+		}
+		if fg.kind.basic == types.Int {
+			return strconv.FormatInt(v, 10), nil
+		}
+		return fmt.Sprintf("%s(%d)", name, v), nil
+	case types.Uint32, types.Uint64:
+		v, err := strconv.ParseUint(str, 10, map[types.BasicKind]int{types.Uint32: 32, types.Uint64: 64}[fg.kind.basic])
+		if err != nil {
+			return "", fmt.Errorf("invalid %s default %q", name, str)
+		}
+		return fmt.Sprintf("%s(%d)", name, v), nil
+	case types.Float64:
+		v, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s default %q", name, str)
+		}
+		return "float64(" + strconv.FormatFloat(v, 'g', -1, 64) + ")", nil
+	}
+	return "", fmt.Errorf("default value not supported on field type %s", fg.field.Type())
+}
+
+// convertImplicit 未声明类型规则时的类型转换，转换失败由其后的规则报告
+func (fg *fieldGen) convertImplicit() {
+	fg.converted = true
+	fg.implicit = true
+	fmt.Fprintf(&fg.body, "val, ok := %s\n", fg.kind.convert)
+}
+
+// convert 类型转换，转换结果为val
+func (fg *fieldGen) convert(args []string) error {
+	fg.converted = true
+	if fg.nested {
+		fmt.Fprintf(&fg.body, "obj, ok := v.(map[string]interface{})\n")
+		fg.fail("!ok", "v", `"object", nil`)
+		fmt.Fprintf(&fg.body, "if ferr := dst.%s.ValidateParams(g.Nested(%q), obj); ferr != nil {\n", fg.field.Name(), fg.key)
+		fmt.Fprintf(&fg.body, "return g.Fail(%s_filter, obj, validator.NestedFail(ferr))\n}\n", fg.prefix)
+		return nil
+	}
+	conv := fg.kind.convert
+	switch {
+	case fg.kind.basic == types.Bool && len(args) == 1:
+		vocab, ok := map[string]string{"std": "utils.StdBoolVocab", "ext": "utils.ExtBoolVocab"}[strings.ToLower(args[0])]
+		if !ok {
+			return fmt.Errorf("unknown boolean vocabulary %q", args[0])
+		}
+		fg.g.imports["github.com/rumis/govalidate/utils"] = true
+		conv = fmt.Sprintf("g.BooleanWith(%s, v)", vocab)
+	case len(args) != 0:
+		return fmt.Errorf("rule %s takes no arguments", fg.kind.rule)
+	}
+	if fg.kind.basic == types.Float64 {
+		fg.g.imports["github.com/rumis/govalidate/executor"] = true
+	}
+	fmt.Fprintf(&fg.body, "val, ok := %s\n", conv)
+	fg.fail(fg.kind.fail, "v", fg.kind.msg)
+	return nil
+}
+
+// check 类型转换后的校验规则，同validator包中的同名规则
+func (fg *fieldGen) check(rule tagRule) error {
+	fg.g.imports["github.com/rumis/govalidate/executor"] = true
+	switch fg.kind.basic {
+	case types.String:
+		return fg.checkString(rule)
+	case types.Int:
+		return fg.checkInt(rule)
+	case types.Int32, types.Int64:
+		return fg.checkInt64(rule)
+	case types.Uint32, types.Uint64:
+		return fg.checkUint64(rule)
+	case types.Float64:
+		return fg.checkFloat(rule)
+	}
+	return fmt.Errorf("unknown rule %q for field type %s", rule.name, fg.field.Type())
+}
+
+// checkString 字符串规则
+func (fg *fieldGen) checkString(rule tagRule) error {
+	if fn, ok := stringChecks[rule.name]; ok {
+		if len(rule.args) != 0 {
+			return fmt.Errorf("rule %s takes no arguments", rule.name)
+		}
+		fg.fail(fmt.Sprintf("!executor.%s(val)", fn), "val", fmt.Sprintf("%q, nil", rule.name))
+		return nil
+	}
+	switch rule.name {
+	case "length":
+		vals, err := intArgs(rule, 2)
+		if err != nil {
+			return err
+		}
+		exe := fg.newVar(fmt.Sprintf("executor.Length(%d, %d)", vals[0], vals[1]))
+		fg.fail("!"+exe+"(val)", "val", fmt.Sprintf(`"length", map[string]interface{}{"min": %d, "max": %d}`, vals[0], vals[1]))
+	case "enumstring":
+		quoted := make([]string, len(rule.args))
+		for idx, arg := range rule.args {
+			quoted[idx] = strconv.Quote(arg)
+		}
+		enums := fg.newVar(fmt.Sprintf("[]string{%s}", strings.Join(quoted, ", ")))
+		exe := fg.newVar(fmt.Sprintf("executor.EnumString(%s)", enums))
+		fg.fail("!"+exe+"(val)", "val", fmt.Sprintf(`"enum", map[string]interface{}{"enum": %s}`, enums))
+	case "regex":
+		exe := fg.newVar(fmt.Sprintf("executor.Regex(%q)", rule.args[0]))
+		fg.fail("!"+exe+"(val)", "val", `"regex", nil`)
+	default:
+		return fmt.Errorf("unknown rule %q for field type %s", rule.name, fg.field.Type())
+	}
+	return nil
+}
+
+// checkInt int规则
+func (fg *fieldGen) checkInt(rule tagRule) error {
+	switch rule.name {
+	case "between":
+		vals, err := intArgs(rule, 2)
+		if err != nil {
+			return err
+		}
+		exe := fg.newVar(fmt.Sprintf("executor.Between(%d, %d)", vals[0], vals[1]))
+		fg.fail("!"+exe+"(val)", "val", fmt.Sprintf(`"between", map[string]interface{}{"min": %d, "max": %d}`, vals[0], vals[1]))
+	case "enumint":
+		vals, err := intArgs(rule, -1)
+		if err != nil {
+			return err
+		}
+		enums := fg.newVar(fmt.Sprintf("[]int{%s}", joinInts(vals)))
+		exe := fg.newVar(fmt.Sprintf("executor.EnumInt(%s)", enums))
+		fg.fail("!"+exe+"(val)", "val", fmt.Sprintf(`"enum", map[string]interface{}{"enum": %s}`, enums))
+	default:
+		return fmt.Errorf("unknown rule %q for field type %s", rule.name, fg.field.Type())
+	}
+	return nil
+}
+
+// checkInt64 int32、int64规则
+func (fg *fieldGen) checkInt64(rule tagRule) error {
+	switch rule.name {
+	case "between", "between64":
+		vals, err := intArgs(rule, 2)
+		if err != nil {
+			return err
+		}
+		exe := fg.newVar(fmt.Sprintf("executor.Between64(%d, %d)", vals[0], vals[1]))
+		fg.fail("!"+exe+"(int64(val))", "val", fmt.Sprintf(`"between", map[string]interface{}{"min": int64(%d), "max": int64(%d)}`, vals[0], vals[1]))
+	case "enumint", "enumint64":
+		vals, err := intArgs(rule, -1)
+		if err != nil {
+			return err
+		}
+		enums := fg.newVar(fmt.Sprintf("[]int64{%s}", joinInts(vals)))
+		exe := fg.newVar(fmt.Sprintf("executor.EnumInt64(%s)", enums))
+		fg.fail("!"+exe+"(int64(val))", "val", fmt.Sprintf(`"enum", map[string]interface{}{"enum": %s}`, enums))
+	default:
+		return fmt.Errorf("unknown rule %q for field type %s", rule.name, fg.field.Type())
+	}
+	return nil
+}
+
+// checkUint64 uint32、uint64规则
+func (fg *fieldGen) checkUint64(rule tagRule) error {
+	switch rule.name {
+	case "between", "betweenuint64":
+		if len(rule.args) != 2 {
+			return fmt.Errorf("rule %s: expect 2 arguments, got %d", rule.name, len(rule.args))
+		}
+		vals := make([]uint64, 2)
+		for idx, arg := range rule.args {
+			v, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				return fmt.Errorf("rule %s: invalid integer argument %q", rule.name, arg)
+			}
+			vals[idx] = v
+		}
+		exe := fg.newVar(fmt.Sprintf("executor.BetweenUint64(%d, %d)", vals[0], vals[1]))
+		fg.fail("!"+exe+"(uint64(val))", "val", fmt.Sprintf(`"between", map[string]interface{}{"min": uint64(%d), "max": uint64(%d)}`, vals[0], vals[1]))
+	default:
+		return fmt.Errorf("unknown rule %q for field type %s", rule.name, fg.field.Type())
+	}
+	return nil
+}
+
+// checkFloat float64规则
+func (fg *fieldGen) checkFloat(rule tagRule) error {
+	switch rule.name {
+	case "positive", "nonnegative":
+		if len(rule.args) != 0 {
+			return fmt.Errorf("rule %s takes no arguments", rule.name)
+		}
+		fn := map[string]string{"positive": "Positive", "nonnegative": "NonNegative"}[rule.name]
+		fg.fail(fmt.Sprintf("!executor.%s(val)", fn), "val", fmt.Sprintf("%q, nil", rule.name))
+		return nil
+	case "maxdecimals":
+		vals, err := intArgs(rule, 1)
+		if err != nil {
+			return err
+		}
+		exe := fg.newVar(fmt.Sprintf("executor.MaxDecimals(%d)", vals[0]))
+		fg.fail("!"+exe+"(val)", "val", fmt.Sprintf(`"decimals", map[string]interface{}{"max": %d}`, vals[0]))
+		return nil
+	}
+	var n int
+	switch rule.name {
+	case "between", "floatbetween":
+		n = 2
+	case "min", "max", "multipleof":
+		n = 1
+	default:
+		return fmt.Errorf("unknown rule %q for field type %s", rule.name, fg.field.Type())
+	}
+	if len(rule.args) != n {
+		return fmt.Errorf("rule %s: expect %d arguments, got %d", rule.name, n, len(rule.args))
+	}
+	lits := make([]string, n)
+	for idx, arg := range rule.args {
+		v, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return fmt.Errorf("rule %s: invalid number argument %q", rule.name, arg)
+		}
+		lits[idx] = "float64(" + strconv.FormatFloat(v, 'g', -1, 64) + ")"
+	}
+	var exe, msg string
+	switch rule.name {
+	case "between", "floatbetween":
+		exe = fmt.Sprintf("executor.FloatBetween(%s, %s)", lits[0], lits[1])
+		msg = fmt.Sprintf(`"between", map[string]interface{}{"min": %s, "max": %s}`, lits[0], lits[1])
+	case "min":
+		exe = fmt.Sprintf("executor.FloatMin(%s)", lits[0])
+		msg = fmt.Sprintf(`"min", map[string]interface{}{"min": %s}`, lits[0])
+	case "max":
+		exe = fmt.Sprintf("executor.FloatMax(%s)", lits[0])
+		msg = fmt.Sprintf(`"max", map[string]interface{}{"max": %s}`, lits[0])
+	case "multipleof":
+		exe = fmt.Sprintf("executor.MultipleOf(%s)", lits[0])
+		msg = fmt.Sprintf(`"multiple", map[string]interface{}{"step": %s}`, lits[0])
+	}
+	fg.fail("!"+fg.newVar(exe)+"(val)", "val", msg)
+	return nil
+}
+
+// intArgs 解析整形参数，n小于0时不限制个数
+func intArgs(rule tagRule, n int) ([]int64, error) {
+	if n >= 0 && len(rule.args) != n {
+		return nil, fmt.Errorf("rule %s: expect %d arguments, got %d", rule.name, n, len(rule.args))
+	}
+	vals := make([]int64, len(rule.args))
+	for idx, arg := range rule.args {
+		v, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: invalid integer argument %q", rule.name, arg)
+		}
+		vals[idx] = v
+	}
+	return vals, nil
+}
+
+// joinInts 逗号分隔的整数
+func joinInts(vals []int64) string {
+	strs := make([]string, len(vals))
+	for idx, v := range vals {
+		strs[idx] = strconv.FormatInt(v, 10)
+	}
+	return strings.Join(strs, ", ")
+}
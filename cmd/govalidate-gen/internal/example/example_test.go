@@ -0,0 +1,116 @@
+package example
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rumis/govalidate"
+	"github.com/rumis/govalidate/utils"
+	"github.com/rumis/govalidate/validator"
+)
+
+// rules 与User标签等价的规则，未声明类型规则的字段同样不加类型规则
+func rules() []validator.Filter {
+	address := []validator.Filter{
+		govalidate.NewFilter("city", []validator.Validator{validator.Required(), validator.Length(1, 20)}),
+		govalidate.NewFilter("zip", []validator.Validator{validator.OmitEmpty(), validator.Regex("^[0-9]{6}$")}),
+	}
+	return []validator.Filter{
+		govalidate.WithLabel(govalidate.NewFilter("name", []validator.Validator{validator.Required(), validator.Length(1, 10)}), "用户名"),
+		govalidate.NewFilter("age", []validator.Validator{validator.Optional(18), validator.Between(1, 120)}, "年龄错误", "10001"),
+		govalidate.NewFilter("email", []validator.Validator{validator.OmitEmpty(), validator.Email()}),
+		govalidate.NewFilter("status", []validator.Validator{validator.Required(), validator.EnumInt([]int{1, 2, 3})}),
+		govalidate.NewFilter("id", []validator.Validator{validator.Required(), validator.Between64(1, 9007199254740991)}),
+		govalidate.NewFilter("score", []validator.Validator{validator.OmitEmpty(), validator.FloatBetween(0, 100), validator.MaxDecimals(1)}),
+		govalidate.NewFilter("vip", []validator.Validator{validator.OmitEmpty(), validator.BooleanWith(utils.ExtBoolVocab)}),
+		govalidate.NewFilter("memo", []validator.Validator{validator.EmptyString(), validator.Length(0, 100)}),
+		govalidate.NewFilter("address", []validator.Validator{validator.Required(), validator.Nested(address)}),
+	}
+}
+
+func params() map[string]interface{} {
+	return map[string]interface{}{
+		"name":    "tom",
+		"email":   "tom@example.com",
+		"status":  "2",
+		"id":      int64(10),
+		"score":   "98.5",
+		"vip":     "on",
+		"memo":    "",
+		"address": map[string]interface{}{"city": "Beijing", "zip": "100000"},
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	ctx := validator.WithConfig(context.Background(), &validator.Config{Lang: "en"})
+	var u User
+	if ferr := u.ValidateParams(ctx, params()); ferr != nil {
+		t.Fatal(ferr)
+	}
+	want := User{Name: "tom", Age: 18, Email: "tom@example.com", Status: 2, ID: 10, Score: 98.5, Vip: true, Address: Address{City: "Beijing", Zip: "100000"}}
+	if u != want {
+		t.Fatalf("%+v", u)
+	}
+
+	// 默认值的类型与字段一致，严格类型模式下同样可用
+	strict := validator.WithConfig(context.Background(), &validator.Config{TypeMode: validator.TM_STRICT})
+	p := params()
+	p["status"], p["score"], p["vip"] = 2, 98.5, true
+	if ferr := u.ValidateParams(strict, p); ferr != nil || u.Age != 18 {
+		t.Fatal(ferr, u.Age)
+	}
+
+	// 错误与validator规则一致
+	cases := []map[string]interface{}{
+		{"name": nil},
+		{"name": "abcdefghijk"},
+		{"age": 0},
+		{"email": "x"},
+		{"status": 4},
+		{"id": "x"},
+		{"id": 0},
+		{"score": 100.5},
+		{"score": 1.25},
+		{"vip": "maybe"},
+		{"memo": strings.Repeat("a", 101)},
+		{"address": "x"},
+		{"address": map[string]interface{}{"city": ""}},
+		{"address": map[string]interface{}{"city": "a", "zip": "1"}},
+		{"address": map[string]interface{}{"city": "a", "zip": ""}},
+		{"age": "x"},
+		{"id": 1.5},
+	}
+	for _, c := range cases {
+		p := params()
+		for k, v := range c {
+			p[k] = v
+		}
+		var u User
+		got := u.ValidateParams(ctx, p)
+		_, want := govalidate.Check(ctx, p, rules())
+		if got == nil || want == nil || *got != *want {
+			t.Fatalf("%v: got %v, want %v", c, got, want)
+		}
+	}
+}
+
+func BenchmarkGenerated(b *testing.B) {
+	ctx := context.Background()
+	p := params()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var u User
+		_ = u.ValidateParams(ctx, p)
+	}
+}
+
+func BenchmarkRules(b *testing.B) {
+	ctx := context.Background()
+	p := params()
+	filters := rules()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = govalidate.Check(ctx, p, filters)
+	}
+}
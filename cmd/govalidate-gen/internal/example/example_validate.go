@@ -0,0 +1,230 @@
+// Code generated by govalidate-gen; DO NOT EDIT.
+
+package example
+
+import (
+	"context"
+
+	"github.com/rumis/govalidate/executor"
+	"github.com/rumis/govalidate/utils"
+	"github.com/rumis/govalidate/validator"
+)
+
+var (
+	_User_Name_filter    = validator.NewNormalFilter("name", nil, "", 0).WithLabel("用户名")
+	_User_Name_1         = executor.Length(1, 10)
+	_User_Age_filter     = validator.NewNormalFilter("age", nil, "年龄错误", 10001)
+	_User_Age_1          = executor.Between(1, 120)
+	_User_Email_filter   = validator.NewNormalFilter("email", nil, "", 0)
+	_User_Status_filter  = validator.NewNormalFilter("status", nil, "", 0)
+	_User_Status_1       = []int{1, 2, 3}
+	_User_Status_2       = executor.EnumInt(_User_Status_1)
+	_User_ID_filter      = validator.NewNormalFilter("id", nil, "", 0)
+	_User_ID_1           = executor.Between64(1, 9007199254740991)
+	_User_Score_filter   = validator.NewNormalFilter("score", nil, "", 0)
+	_User_Score_1        = executor.FloatBetween(float64(0), float64(100))
+	_User_Score_2        = executor.MaxDecimals(1)
+	_User_Vip_filter     = validator.NewNormalFilter("vip", nil, "", 0)
+	_User_Memo_filter    = validator.NewNormalFilter("memo", nil, "", 0)
+	_User_Memo_1         = executor.Length(0, 100)
+	_User_Address_filter = validator.NewNormalFilter("address", nil, "", 0)
+	_Address_City_filter = validator.NewNormalFilter("city", nil, "", 0)
+	_Address_City_1      = executor.Length(1, 20)
+	_Address_Zip_filter  = validator.NewNormalFilter("zip", nil, "", 0)
+	_Address_Zip_1       = executor.Regex("^[0-9]{6}$")
+)
+
+// _User_Name 校验参数name并填充User.Name
+func _User_Name(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if v == nil {
+		return g.Fail(_User_Name_filter, v, validator.FailMsg(nil, "required", nil))
+	}
+	val, ok := g.String(v)
+	if !ok || !_User_Name_1(val) {
+		return g.Fail(_User_Name_filter, val, validator.FailMsg(nil, "length", map[string]interface{}{"min": 1, "max": 10}))
+	}
+	dst.Name = val
+	return nil
+}
+
+// _User_Age 校验参数age并填充User.Age
+func _User_Age(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if executor.IsNil(v) {
+		v = 18
+	}
+	val, ok := g.Int(v)
+	if !ok || !_User_Age_1(val) {
+		return g.Fail(_User_Age_filter, val, validator.FailMsg(nil, "between", map[string]interface{}{"min": 1, "max": 120}))
+	}
+	dst.Age = val
+	return nil
+}
+
+// _User_Email 校验参数email并填充User.Email
+func _User_Email(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if executor.IsNil(v) {
+		return nil
+	}
+	val, ok := g.String(v)
+	if !ok || !executor.Email(val) {
+		return g.Fail(_User_Email_filter, val, validator.FailMsg(nil, "email", nil))
+	}
+	dst.Email = val
+	return nil
+}
+
+// _User_Status 校验参数status并填充User.Status
+func _User_Status(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if v == nil {
+		return g.Fail(_User_Status_filter, v, validator.FailMsg(nil, "required", nil))
+	}
+	val, ok := g.Int(v)
+	if !ok || !_User_Status_2(val) {
+		return g.Fail(_User_Status_filter, val, validator.FailMsg(nil, "enum", map[string]interface{}{"enum": _User_Status_1}))
+	}
+	dst.Status = Status(val)
+	return nil
+}
+
+// _User_ID 校验参数id并填充User.ID
+func _User_ID(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if v == nil {
+		return g.Fail(_User_ID_filter, v, validator.FailMsg(nil, "required", nil))
+	}
+	val, ok := g.Int64(v)
+	if !ok || !_User_ID_1(int64(val)) {
+		return g.Fail(_User_ID_filter, val, validator.FailMsg(nil, "between", map[string]interface{}{"min": int64(1), "max": int64(9007199254740991)}))
+	}
+	dst.ID = val
+	return nil
+}
+
+// _User_Score 校验参数score并填充User.Score
+func _User_Score(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if executor.IsNil(v) {
+		return nil
+	}
+	val, ok := g.Float(v)
+	if !ok || !_User_Score_1(val) {
+		return g.Fail(_User_Score_filter, val, validator.FailMsg(nil, "between", map[string]interface{}{"min": float64(0), "max": float64(100)}))
+	}
+	if !_User_Score_2(val) {
+		return g.Fail(_User_Score_filter, val, validator.FailMsg(nil, "decimals", map[string]interface{}{"max": 1}))
+	}
+	dst.Score = val
+	return nil
+}
+
+// _User_Vip 校验参数vip并填充User.Vip
+func _User_Vip(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if executor.IsNil(v) {
+		return nil
+	}
+	val, ok := g.BooleanWith(utils.ExtBoolVocab, v)
+	if !ok {
+		return g.Fail(_User_Vip_filter, v, validator.FailMsg(nil, "boolean", nil))
+	}
+	dst.Vip = val
+	return nil
+}
+
+// _User_Memo 校验参数memo并填充User.Memo
+func _User_Memo(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if str, ok := utils.GetStringValue(v); ok && len(str) == 0 {
+		return nil
+	} else {
+		v = str
+	}
+	val, ok := g.String(v)
+	if !ok || !_User_Memo_1(val) {
+		return g.Fail(_User_Memo_filter, val, validator.FailMsg(nil, "length", map[string]interface{}{"min": 0, "max": 100}))
+	}
+	dst.Memo = val
+	return nil
+}
+
+// _User_Address 校验参数address并填充User.Address
+func _User_Address(g validator.GenContext, dst *User, v interface{}) *validator.FieldError {
+	if v == nil {
+		return g.Fail(_User_Address_filter, v, validator.FailMsg(nil, "required", nil))
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return g.Fail(_User_Address_filter, v, validator.FailMsg(nil, "object", nil))
+	}
+	if ferr := dst.Address.ValidateParams(g.Nested("address"), obj); ferr != nil {
+		return g.Fail(_User_Address_filter, obj, validator.NestedFail(ferr))
+	}
+	return nil
+}
+
+// ValidateParams 校验参数并填充User，遇到首个错误时中断
+func (dst *User) ValidateParams(ctx context.Context, params map[string]interface{}) *validator.FieldError {
+	g := validator.NewGenContext(ctx)
+	if ferr := _User_Name(g, dst, params["name"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_Age(g, dst, params["age"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_Email(g, dst, params["email"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_Status(g, dst, params["status"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_ID(g, dst, params["id"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_Score(g, dst, params["score"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_Vip(g, dst, params["vip"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_Memo(g, dst, params["memo"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _User_Address(g, dst, params["address"]); ferr != nil {
+		return ferr
+	}
+	return nil
+}
+
+// _Address_City 校验参数city并填充Address.City
+func _Address_City(g validator.GenContext, dst *Address, v interface{}) *validator.FieldError {
+	if v == nil {
+		return g.Fail(_Address_City_filter, v, validator.FailMsg(nil, "required", nil))
+	}
+	val, ok := g.String(v)
+	if !ok || !_Address_City_1(val) {
+		return g.Fail(_Address_City_filter, val, validator.FailMsg(nil, "length", map[string]interface{}{"min": 1, "max": 20}))
+	}
+	dst.City = val
+	return nil
+}
+
+// _Address_Zip 校验参数zip并填充Address.Zip
+func _Address_Zip(g validator.GenContext, dst *Address, v interface{}) *validator.FieldError {
+	if executor.IsNil(v) {
+		return nil
+	}
+	val, ok := g.String(v)
+	if !ok || !_Address_Zip_1(val) {
+		return g.Fail(_Address_Zip_filter, val, validator.FailMsg(nil, "regex", nil))
+	}
+	dst.Zip = val
+	return nil
+}
+
+// ValidateParams 校验参数并填充Address，遇到首个错误时中断
+func (dst *Address) ValidateParams(ctx context.Context, params map[string]interface{}) *validator.FieldError {
+	g := validator.NewGenContext(ctx)
+	if ferr := _Address_City(g, dst, params["city"]); ferr != nil {
+		return ferr
+	}
+	if ferr := _Address_Zip(g, dst, params["zip"]); ferr != nil {
+		return ferr
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// Package example govalidate-gen生成代码的示例
+package example
+
+//go:generate go run github.com/rumis/govalidate/cmd/govalidate-gen -type User,Address
+
+// Status 用户状态
+type Status int
+
+// Address 地址
+type Address struct {
+	City string `json:"city" validate:"required;length:1,20"`
+	Zip  string `json:"zip" validate:"omitempty;regex:^[0-9]{6}$"`
+}
+
+// User 用户
+type User struct {
+	Name    string  `json:"name" validate:"required;length:1,10" label:"用户名"`
+	Age     int     `json:"age" validate:"optional:18;between:1,120" msg:"年龄错误" code:"10001"`
+	Email   string  `json:"email" validate:"omitempty;email"`
+	Status  Status  `json:"status" validate:"required;enumint:1,2,3"`
+	ID      int64   `json:"id" validate:"required;between64:1,9007199254740991"`
+	Score   float64 `json:"score" validate:"omitempty;floatbetween:0,100;maxdecimals:1"`
+	Vip     bool    `json:"vip" validate:"omitempty;boolean:ext"`
+	Memo    string  `json:"memo" validate:"emptystring;length:0,100"`
+	Address Address `json:"address" validate:"required"`
+	Remark  string
+}
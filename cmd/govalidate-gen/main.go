@@ -0,0 +1,185 @@
+// govalidate-gen 根据结构体标签生成校验代码，校验参数并直接填充结构体，不使用反射
+//
+//	//go:generate govalidate-gen -type User,Address
+//
+//	type User struct {
+//	    Name    string  `json:"name" validate:"required;length:1,20" label:"用户名"`
+//	    Age     int     `json:"age" validate:"optional:18;between:1,120" msg:"年龄错误" code:"10001"`
+//	    Email   string  `json:"email" validate:"omitempty;email"`
+//	    Address Address `json:"address" validate:"required"`
+//	}
+//
+// 生成 func (dst *User) ValidateParams(ctx context.Context, params map[string]interface{}) *validator.FieldError
+// 参数KEY取json标签，未设置时为字段名；validate为分号分隔的规则，规则格式同规则定义文件，regex的参数为剩余的全部内容
+// 类型转换由字段类型决定，与Int、String等规则一致，未声明类型规则时转换失败由其后首条规则报告，与运行时规则链一致
+// optional的默认值按字段类型生成字面量；msg、code、label同Filter的错误信息、错误码、显示名称
+// 包存在类型错误时不生成代码，退出码为1
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// generatedHeader 生成文件的首行
+const generatedHeader = "// Code generated by govalidate-gen; DO NOT EDIT."
+
+// config 命令行参数
+type config struct {
+	types  []string
+	output string
+	tag    string
+	dir    string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+// run 执行命令，返回退出码
+func run(args []string, stderr io.Writer) int {
+	cfg, err := parseFlags(args, stderr)
+	if err != nil {
+		return exitUsage
+	}
+	pkg, err := loadPackage(cfg.dir, cfg.output)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	src, err := generate(pkg, cfg.types, cfg.tag)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	if err := ioutil.WriteFile(cfg.output, src, 0644); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	return exitOK
+}
+
+// parseFlags 解析命令行参数
+func parseFlags(args []string, stderr io.Writer) (config, error) {
+	var cfg config
+	var typeList string
+	fs := flag.NewFlagSet("govalidate-gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&typeList, "type", "", "comma-separated struct names; default all structs with validate tags")
+	fs.StringVar(&cfg.output, "output", "", "output file; default <package>_validate.go")
+	fs.StringVar(&cfg.tag, "tag", "validate", "struct tag holding the rules")
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+	switch fs.NArg() {
+	case 0:
+		cfg.dir = "."
+	case 1:
+		cfg.dir = fs.Arg(0)
+	default:
+		err := errors.New("expect at most one package directory")
+		fmt.Fprintln(stderr, err)
+		return cfg, err
+	}
+	for _, name := range strings.Split(typeList, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			cfg.types = append(cfg.types, name)
+		}
+	}
+	if cfg.output == "" {
+		name, err := packageName(cfg.dir)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return cfg, err
+		}
+		cfg.output = filepath.Join(cfg.dir, name+"_validate.go")
+	}
+	return cfg, nil
+}
+
+// sourceFiles 目录中参与生成的源文件，不含测试文件及govalidate-gen生成的文件
+func sourceFiles(dir string, exclude string) ([]string, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	excludeAbs, _ := filepath.Abs(exclude)
+	files := make([]string, 0, len(names))
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if abs, _ := filepath.Abs(name); exclude != "" && abs == excludeAbs {
+			continue
+		}
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(data, []byte(generatedHeader)) {
+			continue
+		}
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// packageName 目录中的包名
+func packageName(dir string) (string, error) {
+	files, err := sourceFiles(dir, "")
+	if err != nil {
+		return "", err
+	}
+	for _, name := range files {
+		f, err := parser.ParseFile(token.NewFileSet(), name, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return "", err
+		}
+		return f.Name.Name, nil
+	}
+	return "", fmt.Errorf("no Go files in %s", dir)
+}
+
+// loadPackage 解析并检查目录中的包，依赖包从源码加载，存在类型错误时失败
+func loadPackage(dir string, output string) (*types.Package, error) {
+	files, err := sourceFiles(dir, output)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	fset := token.NewFileSet()
+	parsed := make([]*ast.File, 0, len(files))
+	for _, name := range files {
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+	}
+	return conf.Check(parsed[0].Name.Name, fset, parsed, nil)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	// 生成结果与示例包中的代码一致
+	out := filepath.Join(t.TempDir(), "example_validate.go")
+	stderr := bytes.NewBuffer(nil)
+	if code := run([]string{"-type", "User,Address", "-output", out, "internal/example"}, stderr); code != exitOK {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	got, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile("internal/example/example_validate.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("generated code is stale, run go generate ./cmd/govalidate-gen/internal/example")
+	}
+
+	dir := t.TempDir()
+	cases := map[string]string{
+		"type T struct {\n\tA []int `validate:\"required\"`\n}":              "unsupported field type []int",
+		"type T struct {\n\tA string `validate:\"between:1,2\"`\n}":          `unknown rule "between" for field type string`,
+		"type T struct {\n\tA string `validate:\"int\"`\n}":                  "rule int does not match field type string",
+		"type T struct {\n\tA int `validate:\"int;required\"`\n}":            "rule required must precede type conversion rules",
+		"type T struct {\n\tA int `validate:\"between:1\"`\n}":               "rule between: expect 2 arguments, got 1",
+		"type T struct {\n\tA S `validate:\"required\"`\n}\ntype S struct{}": "struct S has no generated validator",
+		"type T struct {\n\tA Undefined `validate:\"required\"`\n}":          "undefined: Undefined",
+		"type T struct {\n\tA int `validate:\"optional:x\"`\n}":              `rule optional: invalid int default "x"`,
+	}
+	for src, msg := range cases {
+		if err := ioutil.WriteFile(filepath.Join(dir, "t.go"), []byte("package t\n\n"+src+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		stderr.Reset()
+		if code := run([]string{dir}, stderr); code != exitError || !strings.Contains(stderr.String(), msg) {
+			t.Fatalf("%s: exit %d: %s", src, code, stderr.String())
+		}
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	rules := parseTag("required; length:1, 10;regex:^a;b$")
+	if len(rules) != 3 || rules[1].name != "length" || rules[1].args[1] != "10" || rules[2].args[0] != "^a;b$" {
+		t.Fatal(rules)
+	}
+}
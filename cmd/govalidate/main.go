@@ -0,0 +1,353 @@
+// govalidate 按规则文件校验JSON、NDJSON、CSV数据
+//
+//	govalidate -rules rules.json [--all-errors] [--lang en] [--format json|text] [--input-format auto|json|ndjson|csv] file...
+//
+// 全部数据校验通过时输出校验后的数据，退出码为0
+// 存在校验失败时输出错误报告，退出码为1；参数、规则或读取错误时退出码为2
+// --lang 选择错误信息语言，规则文件的messages未定义该语言时使用内置错误信息（zh-CN、en）
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rumis/govalidate"
+	"github.com/rumis/govalidate/validator"
+)
+
+const (
+	exitOK      = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+// reportError 错误报告中的单条错误
+type reportError struct {
+	File    string `json:"file"`
+	Line    int    `json:"line,omitempty"`
+	Record  int    `json:"record"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Code    int32  `json:"code"`
+}
+
+// String 文本格式
+func (e reportError) String() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s: line %d, column %q: %s", e.File, e.Line, e.Field, e.Message)
+	}
+	return fmt.Sprintf("%s: record %d, field %q: %s", e.File, e.Record, e.Field, e.Message)
+}
+
+// report 校验结果
+type report struct {
+	Valid   bool                     `json:"valid"`
+	Records []map[string]interface{} `json:"records,omitempty"`
+	Errors  []reportError            `json:"errors,omitempty"`
+}
+
+// config 命令行参数
+type config struct {
+	rules       string
+	allErrors   bool
+	lang        string
+	format      string
+	inputFormat string
+	files       []string
+}
+
+// mapLocalizer 规则文件中的多语言消息
+type mapLocalizer map[string]string
+
+// Localize 翻译，未定义的消息原样返回
+func (l mapLocalizer) Localize(id string) string {
+	if msg, ok := l[id]; ok {
+		return msg
+	}
+	return id
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run 执行命令，返回退出码
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	cfg, err := parseFlags(args, stderr)
+	if err != nil {
+		return exitUsage
+	}
+	data, err := ioutil.ReadFile(cfg.rules)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+	set, err := govalidate.ParseRules(data)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	ctx := context.Background()
+	if cfg.lang != "" {
+		// 规则文件未定义该语言时使用内置错误信息
+		ctx = validator.WithLang(ctx, cfg.lang)
+		if msgs, ok := set.Messages[cfg.lang]; ok {
+			ctx = context.WithValue(ctx, validator.GetLocalizerKey(), mapLocalizer(msgs))
+		}
+	}
+
+	rep := report{
+		Records: make([]map[string]interface{}, 0),
+		Errors:  make([]reportError, 0),
+	}
+	for _, file := range cfg.files {
+		if err := validateFile(ctx, cfg, set.Filters, file, stdin, &rep); err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", file, err)
+			return exitUsage
+		}
+	}
+	rep.Valid = len(rep.Errors) == 0
+
+	if err := writeReport(stdout, cfg.format, rep); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+	if !rep.Valid {
+		return exitInvalid
+	}
+	return exitOK
+}
+
+// parseFlags 解析命令行参数
+func parseFlags(args []string, stderr io.Writer) (config, error) {
+	var cfg config
+	fs := flag.NewFlagSet("govalidate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&cfg.rules, "rules", "", "rule definition file (JSON)")
+	fs.BoolVar(&cfg.allErrors, "all-errors", false, "report all field errors of a record instead of the first one")
+	fs.StringVar(&cfg.lang, "lang", "", "language of error messages, defined in the rule file or built in (zh-CN, en)")
+	fs.StringVar(&cfg.format, "format", "text", "output format: json|text")
+	fs.StringVar(&cfg.inputFormat, "input-format", "auto", "input format: auto|json|ndjson|csv")
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+	cfg.files = fs.Args()
+	switch {
+	case cfg.rules == "":
+		err := errors.New("-rules is required")
+		fmt.Fprintln(stderr, err)
+		return cfg, err
+	case len(cfg.files) == 0:
+		err := errors.New("no input files")
+		fmt.Fprintln(stderr, err)
+		return cfg, err
+	case cfg.format != "json" && cfg.format != "text":
+		err := fmt.Errorf("unknown format %q", cfg.format)
+		fmt.Fprintln(stderr, err)
+		return cfg, err
+	}
+	switch cfg.inputFormat {
+	case "auto", "json", "ndjson", "csv":
+	default:
+		err := fmt.Errorf("unknown input format %q", cfg.inputFormat)
+		fmt.Fprintln(stderr, err)
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// inputFormat 文件格式，auto时按扩展名判断
+func inputFormat(cfg config, file string) (string, error) {
+	if cfg.inputFormat != "auto" {
+		return cfg.inputFormat, nil
+	}
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		return "json", nil
+	case ".ndjson", ".jsonl":
+		return "ndjson", nil
+	case ".csv":
+		return "csv", nil
+	}
+	return "", errors.New("unknown input format, use --input-format")
+}
+
+// validateFile 校验单个文件，结果写入rep
+func validateFile(ctx context.Context, cfg config, rules []validator.Filter, file string, stdin io.Reader, rep *report) error {
+	format, err := inputFormat(cfg, file)
+	if err != nil {
+		return err
+	}
+	var r io.Reader = stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	switch format {
+	case "csv":
+		opts := govalidate.CSVOptions{AllErrors: cfg.allErrors}
+		csvRep, err := govalidate.ValidateCSV(ctx, r, rules, opts, func(line int, row map[string]interface{}) error {
+			rep.Records = append(rep.Records, row)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, rej := range csvRep.Rejected {
+			rep.Errors = append(rep.Errors, reportError{
+				File:    file,
+				Line:    rej.Line,
+				Record:  rej.Index,
+				Field:   rej.Column,
+				Message: rej.Msg,
+				Code:    rej.Code,
+			})
+		}
+		return nil
+	case "json":
+		records, err := readJSON(r)
+		if err != nil {
+			return err
+		}
+		for idx, params := range records {
+			validateRecord(ctx, cfg, rules, file, idx, 0, params, rep)
+		}
+		return nil
+	case "ndjson":
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		line, idx := 0, 0
+		for scanner.Scan() {
+			line++
+			text := bytes.TrimSpace(scanner.Bytes())
+			if len(text) == 0 {
+				continue
+			}
+			params, err := decodeObject(text)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+			validateRecord(ctx, cfg, rules, file, idx, line, params, rep)
+			idx++
+		}
+		return scanner.Err()
+	}
+	return fmt.Errorf("unknown input format %q", format)
+}
+
+// validateRecord 校验单条记录
+func validateRecord(ctx context.Context, cfg config, rules []validator.Filter, file string, idx int, line int, params map[string]interface{}, rep *report) {
+	var res map[string]interface{}
+	var ferrs []*validator.FieldError
+	if cfg.allErrors {
+		res, ferrs = govalidate.ValidateAll(ctx, params, rules)
+	} else {
+		var err error
+		res, _, err = govalidate.Validate1(ctx, params, rules)
+		var ferr *validator.FieldError
+		if errors.As(err, &ferr) {
+			ferrs = append(ferrs, ferr)
+		}
+	}
+	if len(ferrs) == 0 {
+		rep.Records = append(rep.Records, res)
+		return
+	}
+	for _, ferr := range ferrs {
+		rep.Errors = append(rep.Errors, reportError{
+			File:    file,
+			Line:    line,
+			Record:  idx,
+			Field:   ferr.Field,
+			Message: ferr.Msg,
+			Code:    ferr.Code,
+		})
+	}
+}
+
+// readJSON 读取JSON文件，内容为单个对象或对象数组
+func readJSON(r io.Reader) ([]map[string]interface{}, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		params, err := decodeObject(data)
+		if err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{params}, nil
+	}
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+	records := make([]map[string]interface{}, len(raws))
+	for idx, raw := range raws {
+		params, err := decodeObject(raw)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", idx, err)
+		}
+		records[idx] = params
+	}
+	return records, nil
+}
+
+// decodeObject 解析JSON对象，数字保留为json.Number
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	var params map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&params); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("record is not a JSON object")
+	}
+	return params, nil
+}
+
+// writeReport 输出校验结果
+// text格式：通过时逐行输出校验后的数据，失败时逐行输出错误
+func writeReport(w io.Writer, format string, rep report) error {
+	if format == "json" {
+		if !rep.Valid {
+			rep.Records = nil
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rep)
+	}
+	if !rep.Valid {
+		for _, e := range rep.Errors {
+			if _, err := fmt.Fprintln(w, e.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	enc := json.NewEncoder(w)
+	for _, rec := range rep.Records {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
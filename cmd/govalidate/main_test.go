@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content string) string {
+		p := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	rules := write("rules.json", `{
+		"messages": {"en": {"age.error": "age must be an integer"}},
+		"fields": [
+			{"key": "name", "rules": ["required", "length:1,5"], "msg": "name error"},
+			{"key": "age", "rules": ["required", {"name": "int", "msg": "age.error"}], "code": 10001}
+		]
+	}`)
+	good := write("good.ndjson", "{\"name\":\"a\",\"age\":1}\n\n{\"name\":\"b\",\"age\":\"2\"}\n")
+	bad := write("bad.json", `[{"name":"a","age":1},{"name":"toolong","age":"x"}]`)
+	csv := write("bad.csv", "name,age\nc,3\n,x\n")
+
+	stdout := bytes.NewBuffer(nil)
+	stderr := bytes.NewBuffer(nil)
+	if code := run([]string{"-rules", rules, good}, nil, stdout, stderr); code != exitOK {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	if stdout.String() != "{\"age\":1,\"name\":\"a\"}\n{\"age\":2,\"name\":\"b\"}\n" {
+		t.Fatal(stdout.String())
+	}
+
+	stdout.Reset()
+	if code := run([]string{"-rules", rules, "--all-errors", "--lang", "en", bad, csv}, nil, stdout, stderr); code != exitInvalid {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	expect := []string{
+		bad + `: record 1, field "name": name error`,
+		bad + `: record 1, field "age": age must be an integer`,
+		csv + `: line 3, column "name": name error`,
+		csv + `: line 3, column "age": age must be an integer`,
+	}
+	if len(lines) != len(expect) {
+		t.Fatal(stdout.String())
+	}
+	for idx := range lines {
+		if lines[idx] != expect[idx] {
+			t.Fatalf("line %d: %s", idx, lines[idx])
+		}
+	}
+
+	stdout.Reset()
+	if code := run([]string{"-rules", rules, "--format", "json", bad}, nil, stdout, stderr); code != exitInvalid {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), `"valid": false`) || strings.Contains(stdout.String(), `"records"`) {
+		t.Fatal(stdout.String())
+	}
+
+	// 规则文件未定义的语言使用内置错误信息
+	plain := write("plain.json", `{"fields": [{"key": "name", "rules": ["required"]}]}`)
+	empty := write("empty.json", `[{}]`)
+	stdout.Reset()
+	if code := run([]string{"-rules", plain, "--lang", "zh-CN", empty}, nil, stdout, stderr); code != exitInvalid {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	if strings.TrimSpace(stdout.String()) != empty+`: record 0, field "name": name不能为空` {
+		t.Fatal(stdout.String())
+	}
+
+	// CSV单元格包含换行时记录序号与行号无关
+	multi := write("multi.csv", "name,age\n\"a\nb\",1\nc,x\n")
+	stdout.Reset()
+	if code := run([]string{"-rules", rules, "--format", "json", multi}, nil, stdout, stderr); code != exitInvalid {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), `"line": 4`) || !strings.Contains(stdout.String(), `"record": 1`) {
+		t.Fatal(stdout.String())
+	}
+
+	stderr.Reset()
+	if code := run([]string{"-rules", rules, "--input-format", "xml", good}, nil, stdout, stderr); code != exitUsage || !strings.Contains(stderr.String(), `unknown input format "xml"`) {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+
+	if code := run([]string{bad}, nil, stdout, stderr); code != exitUsage {
+		t.Fatalf("exit %d", code)
+	}
+}
@@ -0,0 +1,205 @@
+package govalidate
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+// CSVOptions CSV校验配置
+type CSVOptions struct {
+	// Aliases 参数KEY对应的表头别名，匹配时忽略大小写
+	Aliases map[string][]string
+	// Comma 分隔符，默认为英文逗号
+	Comma rune
+	// AllErrors 报告每行全部字段的错误，默认只报告首个错误
+	AllErrors bool
+}
+
+// CSVError CSV数据行校验错误
+type CSVError struct {
+	// Line 数据行在文件中的起始行号
+	Line int
+	// Index 数据行序号，从0开始，不含表头，单元格包含换行时与Line无固定关系
+	Index  int
+	Column string
+	Msg    string
+	Code   int32
+	Record []string
+}
+
+// Error 错误信息
+func (e CSVError) Error() string {
+	return fmt.Sprintf("line %d, column %q: %s", e.Line, e.Column, e.Msg)
+}
+
+// CSVReport CSV校验报告
+type CSVReport struct {
+	Header   []string
+	Total    int
+	Rejected []CSVError
+}
+
+// WriteRejected 将校验失败的数据行写为CSV，末尾追加行号和错误信息两列
+// 同一行的多个错误合并为一行输出，错误信息以分号分隔
+// 数据行的列数多于表头时保留多出的单元格，表头以空列补齐
+func (r *CSVReport) WriteRejected(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	width := len(r.Header)
+	for _, rej := range r.Rejected {
+		if len(rej.Record) > width {
+			width = len(rej.Record)
+		}
+	}
+	header := make([]string, width, width+2)
+	copy(header, r.Header)
+	header = append(header, "_line", "_error")
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for idx := 0; idx < len(r.Rejected); {
+		rej := r.Rejected[idx]
+		msgs := []string{rej.Msg}
+		for idx++; idx < len(r.Rejected) && r.Rejected[idx].Line == rej.Line; idx++ {
+			msgs = append(msgs, r.Rejected[idx].Msg)
+		}
+		row := make([]string, width, width+2)
+		copy(row, rej.Record)
+		row = append(row, strconv.Itoa(rej.Line), strings.Join(msgs, "; "))
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ValidateCSV 使用默认引擎逐行校验CSV数据
+func ValidateCSV(ctx context.Context, r io.Reader, rules []validator.Filter, opts CSVOptions, fn func(line int, row map[string]interface{}) error) (*CSVReport, error) {
+	return defaultEngine.ValidateCSV(ctx, r, rules, opts, fn)
+}
+
+// ValidateCSV 逐行校验CSV数据
+// 首行为表头，表头按rules的KEY及opts.Aliases映射为参数KEY，未匹配的列以表头原文作为KEY
+// 空单元格视为参数缺失，由Required、Optional、OmitEmpty等规则决定处理方式
+// 行号为数据行在文件中的起始行号，单元格包含换行时与记录序号不同
+// 校验通过的数据依次传给fn，fn返回错误时终止读取
+func (e *Engine) ValidateCSV(ctx context.Context, r io.Reader, rules []validator.Filter, opts CSVOptions, fn func(line int, row map[string]interface{}) error) (*CSVReport, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	header, err := reader.Read()
+	if err == io.EOF {
+		return &CSVReport{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	keys, columns := csvHeaderKeys(ctx, header, rules, opts.Aliases)
+
+	report := &CSVReport{
+		Header:   header,
+		Rejected: make([]CSVError, 0),
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return report, err
+		}
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		line, _ := reader.FieldPos(0)
+		report.Total++
+
+		params := make(map[string]interface{}, len(keys))
+		for idx, cell := range record {
+			if idx >= len(keys) || cell == "" {
+				continue
+			}
+			params[keys[idx]] = cell
+		}
+		var row map[string]interface{}
+		var ferrs []*validator.FieldError
+		if opts.AllErrors {
+			row, ferrs = e.ValidateAll(ctx, params, rules)
+		} else {
+			var err error
+			row, _, err = e.Validate1(ctx, params, rules)
+			var ferr *validator.FieldError
+			if errors.As(err, &ferr) {
+				ferrs = append(ferrs, ferr)
+			}
+		}
+		if len(ferrs) > 0 {
+			for _, ferr := range ferrs {
+				cerr := CSVError{
+					Line:   line,
+					Index:  report.Total - 1,
+					Column: ferr.Field,
+					Msg:    ferr.Msg,
+					Code:   ferr.Code,
+					Record: record,
+				}
+				if col, ok := columns[ferr.Field]; ok {
+					cerr.Column = col
+				}
+				report.Rejected = append(report.Rejected, cerr)
+			}
+			continue
+		}
+		if fn == nil {
+			continue
+		}
+		if err := fn(line, row); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// csvHeaderKeys 计算每一列对应的参数KEY，以及参数KEY对应的表头
+func csvHeaderKeys(ctx context.Context, header []string, rules []validator.Filter, aliases map[string][]string) ([]string, map[string]string) {
+	keys := make([]string, len(header))
+	columns := make(map[string]string, len(header))
+	for idx, col := range header {
+		name := strings.TrimSpace(col)
+		keys[idx] = name
+		for _, filter := range rules {
+			fkey := filter.Key(ctx)
+			if csvColumnMatch(name, fkey, aliases[fkey]) {
+				keys[idx] = fkey
+				break
+			}
+		}
+		columns[keys[idx]] = col
+	}
+	return keys, columns
+}
+
+// csvColumnMatch 表头是否匹配参数KEY或其别名
+func csvColumnMatch(name string, key string, aliases []string) bool {
+	if strings.EqualFold(name, key) {
+		return true
+	}
+	for _, alias := range aliases {
+		if strings.EqualFold(name, alias) {
+			return true
+		}
+	}
+	return false
+}
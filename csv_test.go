@@ -0,0 +1,58 @@
+package govalidate
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+func TestValidateCSV(t *testing.T) {
+	data := "SKU,Product Price,note\n" +
+		"a1,12,\n" +
+		"a2,,\n" +
+		"a3,x1,bad\n" +
+		"a4,8,\"multi\nline\"\n" +
+		"a5,x2,,extra\n"
+	rules := []validator.Filter{
+		NewFilter("sku", []validator.Validator{validator.Required(), validator.String()}),
+		NewFilter("price", []validator.Validator{validator.Optional(0), validator.Int("价格必须为整数")}),
+		NewFilter("note", []validator.Validator{validator.OmitEmpty(), validator.String()}),
+	}
+	opts := CSVOptions{
+		Aliases: map[string][]string{"price": {"product price"}},
+	}
+	rows := make([]map[string]interface{}, 0)
+	report, err := ValidateCSV(context.Background(), strings.NewReader(data), rules, opts, func(line int, row map[string]interface{}) error {
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 5 || len(rows) != 3 || len(report.Rejected) != 2 {
+		t.Fatalf("report: %+v rows: %v", report, rows)
+	}
+	if p, ok := rows[1]["price"].(int); !ok || p != 0 {
+		t.Fatal("empty cell should use default value")
+	}
+	rej := report.Rejected[0]
+	if rej.Error() != `line 4, column "Product Price": 价格必须为整数` {
+		t.Fatal(rej.Error())
+	}
+	// 行号为文件中的物理行号
+	if rej := report.Rejected[1]; rej.Line != 7 || rej.Index != 4 || report.Rejected[0].Index != 2 {
+		t.Fatal(rej.Error())
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := report.WriteRejected(buf); err != nil {
+		t.Fatal(err)
+	}
+	// 超出表头的单元格保留
+	if buf.String() != "SKU,Product Price,note,,_line,_error\na3,x1,bad,,4,价格必须为整数\na5,x2,,extra,7,价格必须为整数\n" {
+		t.Fatal(buf.String())
+	}
+}
@@ -0,0 +1,222 @@
+package govalidate
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/rumis/govalidate/utils"
+	"github.com/rumis/govalidate/validator"
+)
+
+// defaultEngine 包级别校验函数使用的默认引擎
+var defaultEngine = &Engine{}
+
+// Hooks 校验钩子
+type Hooks struct {
+	// Before 校验前调用，可修改参数
+	Before func(ctx context.Context, params map[string]interface{})
+	// After 校验后调用，errs为本次校验的全部错误，校验通过时为空
+	After func(ctx context.Context, res map[string]interface{}, errs []*validator.FieldError)
+}
+
+// Options 校验引擎配置
+type Options struct {
+	// LocalizerKey 从context中获取Localizer的key，为nil时使用validator.GetLocalizerKey()
+	LocalizerKey interface{}
+	// Localizer 获取Localizer，设置后不再按LocalizerKey查找
+	Localizer func(ctx context.Context) validator.Localizer
+	// MessageMode 错误信息模式，为validator.MM_LOCALIZE时所有Filter的错误信息均由Localizer翻译
+	MessageMode validator.MessageMode
+	// Lang 内置默认错误信息的语言，如 zh-CN、en，为空时使用validator.DefaultLang
+	Lang string
+	// DefaultMsg 规则和Filter均未设置错误信息时使用，%s为参数路径，设置后不再使用内置默认错误信息
+	DefaultMsg string
+	// Strict 严格模式，参数中存在规则未定义的字段时校验失败
+	Strict bool
+	// TypeMode 类型模式，为validator.TM_STRICT时Int等规则仅接受对应类型的参数，不做字符串转换
+	TypeMode validator.TypeMode
+	// BoolVocab 宽松模式下Boolean规则使用的布尔值词表，如 utils.ExtBoolVocab，为nil时与strconv.ParseBool一致
+	BoolVocab *utils.BoolVocab
+	// Location Time规则解析不含时区信息的时间时使用的时区，为nil时使用validator.DefaultLocation
+	Location *time.Location
+	// Now NotInFuture、WithinNext等相对时间规则使用的当前时间函数，为nil时使用time.Now，测试时可固定时间
+	Now func() time.Time
+	// MaxParams 参数个数上限，0为不限制
+	MaxParams int
+	// MaxErrors ValidateAll返回的错误个数上限，0为不限制
+	MaxErrors int
+	// MaxDepth 命名规则集引用深度的上限，与Schemas的设置取较小值，只能收紧不能放宽，0为使用Schemas的设置
+	MaxDepth int
+	// Hooks 校验钩子
+	Hooks Hooks
+}
+
+// Engine 校验引擎，持有独立的配置，可并发使用
+type Engine struct {
+	opts Options
+	cfg  *validator.Config
+}
+
+// New 创建校验引擎
+func New(opts Options) *Engine {
+	return &Engine{
+		opts: opts,
+		cfg: &validator.Config{
+			LocalizerKey: opts.LocalizerKey,
+			Localizer:    opts.Localizer,
+			MessageMode:  opts.MessageMode,
+			Lang:         opts.Lang,
+			DefaultMsg:   opts.DefaultMsg,
+			MaxDepth:     opts.MaxDepth,
+			TypeMode:     opts.TypeMode,
+			BoolVocab:    opts.BoolVocab,
+			Location:     opts.Location,
+			Now:          opts.Now,
+		},
+	}
+}
+
+// Default 默认引擎，即包级别校验函数使用的引擎
+func Default() *Engine {
+	return defaultEngine
+}
+
+// Options 引擎配置
+func (e *Engine) Options() Options {
+	return e.opts
+}
+
+// context 将引擎配置写入context
+func (e *Engine) context(ctx context.Context) context.Context {
+	if e.cfg == nil {
+		return ctx
+	}
+	return validator.WithConfig(ctx, e.cfg)
+}
+
+// Validate 校验
+func (e *Engine) Validate(params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, int32, error) {
+	return e.Validate1(context.Background(), params, rules)
+}
+
+// Validate1 校验，遇到首个错误时中断
+// 校验通过时返回validator.CODE_SUCCESS，失败时返回的error为*validator.FieldError
+func (e *Engine) Validate1(ctx context.Context, params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, int32, error) {
+	if len(rules) == 0 {
+		return nil, validator.CODE_NONE, nil
+	}
+	vRes, ferr := e.Check(ctx, params, rules)
+	if ferr != nil {
+		return vRes, ferr.Code, ferr
+	}
+	return vRes, validator.CODE_SUCCESS, nil
+}
+
+// Check 校验，遇到首个错误时中断
+// 校验通过时错误为nil，失败时错误码为规则的错误码，规则未设置时为Filter的错误码
+func (e *Engine) Check(ctx context.Context, params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, *validator.FieldError) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	ctx = e.context(ctx)
+	if e.opts.Hooks.Before != nil {
+		e.opts.Hooks.Before(ctx, params)
+	}
+	vRes := make(map[string]interface{})
+	ferr := e.checkParams(ctx, params)
+	if ferr == nil {
+		for _, filter := range rules {
+			if ferr = validator.ValidateFilter(ctx, filter, params, vRes); ferr != nil {
+				break
+			}
+		}
+	}
+	if ferr == nil && e.opts.Strict {
+		if errs := e.unknownParams(ctx, params, rules, vRes); len(errs) > 0 {
+			ferr = errs[0]
+		}
+	}
+	if e.opts.Hooks.After != nil {
+		var errs []*validator.FieldError
+		if ferr != nil {
+			errs = append(errs, ferr)
+		}
+		e.opts.Hooks.After(ctx, vRes, errs)
+	}
+	return vRes, ferr
+}
+
+// ValidateAll 校验全部参数，不在首个错误处中断
+// 返回所有校验失败字段的错误，全部通过时错误列表为空
+func (e *Engine) ValidateAll(ctx context.Context, params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, []*validator.FieldError) {
+	errs := make([]*validator.FieldError, 0)
+	if len(rules) == 0 {
+		return nil, errs
+	}
+	ctx = e.context(ctx)
+	if e.opts.Hooks.Before != nil {
+		e.opts.Hooks.Before(ctx, params)
+	}
+	vRes := make(map[string]interface{})
+	if ferr := e.checkParams(ctx, params); ferr != nil {
+		errs = append(errs, ferr)
+	} else {
+		for _, filter := range rules {
+			if e.opts.MaxErrors > 0 && len(errs) >= e.opts.MaxErrors {
+				break
+			}
+			if ferr := validator.ValidateFilter(ctx, filter, params, vRes); ferr != nil {
+				errs = append(errs, ferr)
+			}
+		}
+		if e.opts.Strict {
+			errs = append(errs, e.unknownParams(ctx, params, rules, vRes)...)
+		}
+		if e.opts.MaxErrors > 0 && len(errs) > e.opts.MaxErrors {
+			errs = errs[:e.opts.MaxErrors]
+		}
+	}
+	if e.opts.Hooks.After != nil {
+		e.opts.Hooks.After(ctx, vRes, errs)
+	}
+	return vRes, errs
+}
+
+// checkParams 检查参数个数限制
+func (e *Engine) checkParams(ctx context.Context, params map[string]interface{}) *validator.FieldError {
+	if e.opts.MaxParams > 0 && len(params) > e.opts.MaxParams {
+		return &validator.FieldError{
+			Msg: validator.Message(ctx, "max_params", map[string]interface{}{"value": len(params), "max": e.opts.MaxParams}),
+		}
+	}
+	return nil
+}
+
+// unknownParams 严格模式下检查规则未定义的参数
+// 参数KEY既不是Filter的KEY也不在校验结果中时视为未定义
+func (e *Engine) unknownParams(ctx context.Context, params map[string]interface{}, rules []validator.Filter, vRes map[string]interface{}) []*validator.FieldError {
+	keys := make(map[string]struct{}, len(rules))
+	for _, filter := range rules {
+		keys[filter.Key(ctx)] = struct{}{}
+	}
+	unknown := make([]string, 0)
+	for k := range params {
+		if _, ok := keys[k]; ok {
+			continue
+		}
+		if _, ok := vRes[k]; ok {
+			continue
+		}
+		unknown = append(unknown, k)
+	}
+	sort.Strings(unknown)
+	errs := make([]*validator.FieldError, 0, len(unknown))
+	for _, k := range unknown {
+		errs = append(errs, &validator.FieldError{
+			Field: k,
+			Msg:   validator.Message(ctx, "unknown", map[string]interface{}{"field": k}),
+		})
+	}
+	return errs
+}
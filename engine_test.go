@@ -0,0 +1,295 @@
+package govalidate
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rumis/govalidate/i18n"
+	"github.com/rumis/govalidate/utils"
+	"github.com/rumis/govalidate/validator"
+)
+
+type prefixLocalizer string
+
+func (l prefixLocalizer) Localize(id string) string {
+	return string(l) + id
+}
+
+func TestEngine(t *testing.T) {
+	type ctxKey struct{}
+	rules := []validator.Filter{
+		NewMultiLangFilter("age", []validator.Validator{validator.Required(), validator.Int()}, "age.error", "10001"),
+	}
+	params := map[string]interface{}{"age": "x"}
+
+	t.Run("localizer key", func(t *testing.T) {
+		t.Parallel()
+		e := New(Options{LocalizerKey: ctxKey{}})
+		ctx := context.WithValue(context.Background(), ctxKey{}, prefixLocalizer("en:"))
+		_, code, err := e.Validate1(ctx, params, rules)
+		if err == nil || err.Error() != "en:age.error" || code != 10001 {
+			t.Fatal(err, code)
+		}
+	})
+
+	t.Run("localizer func", func(t *testing.T) {
+		t.Parallel()
+		e := New(Options{Localizer: func(ctx context.Context) validator.Localizer {
+			return prefixLocalizer("zh:")
+		}})
+		_, _, err := e.Validate1(context.Background(), params, rules)
+		if err == nil || err.Error() != "zh:age.error" {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("message mode", func(t *testing.T) {
+		t.Parallel()
+		e := New(Options{
+			MessageMode: validator.MM_LOCALIZE,
+			Localizer: func(ctx context.Context) validator.Localizer {
+				return prefixLocalizer("en:")
+			},
+		})
+		rules := []validator.Filter{
+			NewFilter("age", []validator.Validator{validator.Required(), validator.Int("age.int")}),
+			NewFilter("tags", []validator.Validator{validator.Each([]validator.Validator{validator.Length(1, 2, "tag.length")})}),
+		}
+		_, errs := e.ValidateAll(context.Background(), map[string]interface{}{"age": "x", "tags": []string{"a", "abc"}}, rules)
+		if len(errs) != 2 || errs[0].Msg != "en:age.int" || errs[1].Field != "tags.1" || errs[1].Msg != "en:tag.length" {
+			t.Fatal(errs)
+		}
+		// 默认引擎不翻译普通Filter的错误信息，多语言规则始终翻译
+		ctx := context.WithValue(context.Background(), validator.GetLocalizerKey(), prefixLocalizer("en:"))
+		_, _, err := Validate1(ctx, map[string]interface{}{"age": "x"}, rules)
+		if err == nil || err.Error() != "age.int" {
+			t.Fatal(err)
+		}
+		rules[0] = NewFilter("age", []validator.Validator{validator.IntMultiLang("age.int")})
+		_, _, err = Validate1(ctx, map[string]interface{}{"age": "x"}, rules)
+		if err == nil || err.Error() != "en:age.int" {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("label", func(t *testing.T) {
+		t.Parallel()
+		b := i18n.NewBundle("en")
+		b.AddMessages("en", map[string]string{"field.curpage": "Page", "int.error": "{field} must be an integer"})
+		b.AddMessages("zh-CN", map[string]string{"field.curpage": "页码", "int.error": "{field}必须为整数"})
+		rules := []validator.Filter{
+			WithLabel(NewMultiLangFilter("curpage", []validator.Validator{validator.Int()}, "int.error"), "field.curpage"),
+		}
+		for header, want := range map[string]string{"zh-CN": "页码必须为整数", "en-US": "Page must be an integer"} {
+			_, _, err := Validate1(b.WithAcceptLanguage(context.Background(), header, nil), map[string]interface{}{"curpage": "x"}, rules)
+			if err == nil || err.Error() != want {
+				t.Fatal(header, err)
+			}
+		}
+		// 未设置错误信息时使用默认错误信息
+		rules[0] = WithLabel(NewFilter("curpage", []validator.Validator{validator.Int()}), "field.curpage")
+		_, errs := ValidateAll(b.WithAcceptLanguage(context.Background(), "zh", nil), map[string]interface{}{"curpage": "x"}, rules)
+		if len(errs) != 1 || errs[0].Field != "curpage" || errs[0].Msg != "页码必须为整数" {
+			t.Fatal(errs)
+		}
+	})
+
+	t.Run("codes", func(t *testing.T) {
+		t.Parallel()
+		validator.RegisterCode(20001, "page is required")
+		rules := []validator.Filter{
+			NewFilter("page", []validator.Validator{
+				validator.WithCode(20001, validator.Required()),
+				validator.Int(),
+				validator.WithCode(20003, validator.Between(1, 100)),
+			}, "", "20002"),
+		}
+		cases := []struct {
+			params map[string]interface{}
+			code   int32
+		}{
+			{map[string]interface{}{}, 20001},
+			{map[string]interface{}{"page": "x"}, 20002},
+			{map[string]interface{}{"page": 1000}, 20003},
+		}
+		for _, c := range cases {
+			_, ferr := Check(context.Background(), c.params, rules)
+			if ferr == nil || ferr.Code != c.code {
+				t.Fatal(c.params, ferr)
+			}
+		}
+		if _, ferr := Check(context.Background(), map[string]interface{}{"page": 2}, rules); ferr != nil {
+			t.Fatal(ferr)
+		}
+		if _, code, err := Validate1(context.Background(), map[string]interface{}{"page": 2}, rules); err != nil || code != validator.CODE_SUCCESS {
+			t.Fatal(code, err)
+		}
+		if desc, ok := validator.CodeDesc(20001); !ok || desc != "page is required" {
+			t.Fatal(desc)
+		}
+	})
+
+	t.Run("type mode", func(t *testing.T) {
+		t.Parallel()
+		strict := New(Options{TypeMode: validator.TM_STRICT})
+		rules := []validator.Filter{
+			NewFilter("page", []validator.Validator{validator.Required(), validator.Int()}),
+			NewFilter("on", []validator.Validator{validator.Optional(), validator.Boolean()}),
+		}
+		cases := []struct {
+			params map[string]interface{}
+			ok     bool
+		}{
+			{map[string]interface{}{"page": float64(2)}, false},
+			{map[string]interface{}{"page": json.Number("3")}, true},
+			{map[string]interface{}{"page": json.Number("3.0")}, false},
+			{map[string]interface{}{"page": int64(3)}, true},
+			{map[string]interface{}{"page": "2"}, false},
+			{map[string]interface{}{"page": ""}, false},
+			{map[string]interface{}{"page": 2.5}, false},
+			{map[string]interface{}{"page": 1, "on": true}, true},
+			{map[string]interface{}{"page": 1, "on": "t"}, false},
+		}
+		for _, c := range cases {
+			res, ferr := strict.Check(context.Background(), c.params, rules)
+			if (ferr == nil) != c.ok {
+				t.Fatal(c.params, ferr)
+			}
+			if c.ok && res["page"] == nil {
+				t.Fatal(res)
+			}
+		}
+		// Filter的类型模式优先
+		rules[0] = WithTypeMode(rules[0], validator.TM_COERCE)
+		if _, ferr := strict.Check(context.Background(), map[string]interface{}{"page": "2"}, rules); ferr != nil {
+			t.Fatal(ferr)
+		}
+		rules[0] = WithTypeMode(rules[0], validator.TM_STRICT)
+		if _, ferr := Check(context.Background(), map[string]interface{}{"page": "2"}, rules); ferr == nil {
+			t.Fatal("strict filter")
+		}
+	})
+
+	t.Run("bool vocab", func(t *testing.T) {
+		t.Parallel()
+		e := New(Options{BoolVocab: utils.ExtBoolVocab})
+		strict := New(Options{BoolVocab: utils.ExtBoolVocab, TypeMode: validator.TM_STRICT})
+		rules := []validator.Filter{NewFilter("agree", []validator.Validator{validator.Required(), validator.Boolean()})}
+		cases := []struct {
+			val    interface{}
+			ok     bool
+			expect bool
+		}{
+			{"on", true, true},
+			{" Off ", true, false},
+			{"是", true, true},
+			{"否", true, false},
+			{1, true, true},
+			{json.Number("0"), true, false},
+			{2, false, false},
+			{"maybe", false, false},
+		}
+		for _, c := range cases {
+			res, ferr := e.Check(context.Background(), map[string]interface{}{"agree": c.val}, rules)
+			if (ferr == nil) != c.ok || (c.ok && res["agree"] != c.expect) {
+				t.Fatal(c.val, res, ferr)
+			}
+		}
+		if _, ferr := Check(context.Background(), map[string]interface{}{"agree": "on"}, rules); ferr == nil {
+			t.Fatal("default vocab accepts on")
+		}
+		if _, ferr := strict.Check(context.Background(), map[string]interface{}{"agree": "yes"}, rules); ferr == nil {
+			t.Fatal("strict accepts yes")
+		}
+		if res, ferr := strict.Check(context.Background(), map[string]interface{}{"agree": true}, rules); ferr != nil || res["agree"] != true {
+			t.Fatal(res, ferr)
+		}
+	})
+
+	t.Run("time location", func(t *testing.T) {
+		t.Parallel()
+		set, err := ParseRules([]byte(`{"fields": [{"key": "at", "rules": ["required", "time:datetime,rfc3339"]}]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := New(Options{Location: time.UTC})
+		res, ferr := e.Check(context.Background(), map[string]interface{}{"at": "2024-03-01 10:00:00"}, set.Filters)
+		if ferr != nil || !res["at"].(time.Time).Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)) {
+			t.Fatal(res, ferr)
+		}
+		res, ferr = Check(context.Background(), map[string]interface{}{"at": "2024-03-01 10:00:00"}, set.Filters)
+		if ferr != nil || !res["at"].(time.Time).Equal(time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)) {
+			t.Fatal(res, ferr)
+		}
+		if _, ferr = Check(context.Background(), map[string]interface{}{"at": "2024-03-01"}, set.Filters); ferr == nil {
+			t.Fatal("date accepted")
+		}
+	})
+
+	t.Run("clock", func(t *testing.T) {
+		t.Parallel()
+		now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		e := New(Options{Location: time.UTC, Now: func() time.Time { return now }})
+		set, err := ParseRules([]byte(`{"fields": [{"key": "start", "rules": ["required", "time:date", "withinnext:90d"]}]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for date, ok := range map[string]bool{"2024-03-01": true, "2024-03-02": true, "2024-05-30": true, "2024-05-31": false, "2024-02-29": false} {
+			if _, ferr := e.Check(context.Background(), map[string]interface{}{"start": date}, set.Filters); (ferr == nil) != ok {
+				t.Fatal(date, ferr)
+			}
+		}
+		if _, err := ParseRules([]byte(`{"fields": [{"key": "start", "rules": ["withinnext:x"]}]}`)); err == nil {
+			t.Fatal("invalid duration")
+		}
+	})
+
+	t.Run("date range", func(t *testing.T) {
+		t.Parallel()
+		set, err := ParseRules([]byte(`{"fields": [{"key": "start_date", "rules": ["required", "daterange:start_date,end_date,31d"]}]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := New(Options{Location: time.UTC, Lang: "en"})
+		res, ferr := e.Check(context.Background(), map[string]interface{}{"start_date": "2024-01-01", "end_date": "2024-01-31"}, set.Filters)
+		if ferr != nil || !res["start_date"].(time.Time).Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) || !res["end_date"].(time.Time).Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)) {
+			t.Fatal(res, ferr)
+		}
+		_, ferr = e.Check(context.Background(), map[string]interface{}{"start_date": "2024-01-01", "end_date": "2024-02-01"}, set.Filters)
+		if ferr == nil || ferr.Field != "start_date" || ferr.Msg != "the range from start_date to end_date must not exceed 31d" {
+			t.Fatal(ferr)
+		}
+	})
+
+	t.Run("strict and limits", func(t *testing.T) {
+		t.Parallel()
+		errCnt := 0
+		e := New(Options{
+			Strict:     true,
+			MaxParams:  3,
+			DefaultMsg: "%s 不合法",
+			Hooks: Hooks{
+				After: func(ctx context.Context, res map[string]interface{}, errs []*validator.FieldError) {
+					errCnt += len(errs)
+				},
+			},
+		})
+		rules := []validator.Filter{
+			NewFilter("age", []validator.Validator{validator.Required(), validator.Int()}),
+			NewFilter("name", []validator.Validator{validator.Required(), validator.ResetKey("nickname")}),
+		}
+		_, errs := e.ValidateAll(context.Background(), map[string]interface{}{"age": "x", "name": "a", "memo": "m"}, rules)
+		if len(errs) != 2 || errs[0].Msg != "age 不合法" || errs[1].Field != "memo" {
+			t.Fatal(errs)
+		}
+		_, _, err := e.Validate(map[string]interface{}{"a": 1, "b": 2, "c": 3, "d": 4}, rules)
+		if err == nil {
+			t.Fatal("max params")
+		}
+		if errCnt != 3 {
+			t.Fatalf("hook error count: %d", errCnt)
+		}
+	})
+}
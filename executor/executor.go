@@ -1,10 +1,13 @@
 package executor
 
 import (
+	"math"
+	"math/big"
 	"net"
 	"net/url"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -13,6 +16,15 @@ import (
 // IntExecutor 检查整形值是否符合要求
 type IntExecutor func(val int) bool
 
+// Int64Executor 检查int64值是否符合要求
+type Int64Executor func(val int64) bool
+
+// Uint64Executor 检查uint64值是否符合要求
+type Uint64Executor func(val uint64) bool
+
+// FloatExecutor 检查浮点值是否符合要求
+type FloatExecutor func(val float64) bool
+
 // StringExecutor 检查字符串值是否符合要求
 type StringExecutor func(val string) bool
 
@@ -68,9 +80,9 @@ func Datetime(val string) bool {
 	return err == nil
 }
 
-// RFC3339
+// DatetimeRFC3339 RFC3339格式的时间，支持时区偏移及小数秒
 func DatetimeRFC3339(val string) bool {
-	_, err := time.Parse("2006-01-02T15:04:05Z", val)
+	_, err := time.Parse(time.RFC3339, val)
 	return err == nil
 }
 
@@ -107,6 +119,102 @@ func EnumInt(enums []int) IntExecutor {
 	}
 }
 
+// Between64 int64数字值范围限制 [min,max]
+func Between64(min int64, max int64) Int64Executor {
+	return func(val int64) bool {
+		return val >= min && val <= max
+	}
+}
+
+// BetweenUint64 uint64数字值范围限制 [min,max]
+func BetweenUint64(min uint64, max uint64) Uint64Executor {
+	return func(val uint64) bool {
+		return val >= min && val <= max
+	}
+}
+
+// EnumInt64 int64枚举
+func EnumInt64(enums []int64) Int64Executor {
+	return func(val int64) bool {
+		for _, v := range enums {
+			if val == v {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Finite 浮点数不为NaN及正负无穷
+func Finite(val float64) bool {
+	return !math.IsNaN(val) && !math.IsInf(val, 0)
+}
+
+// FloatBetween 浮点值范围限制 [min,max]
+func FloatBetween(min float64, max float64) FloatExecutor {
+	return func(val float64) bool {
+		return Finite(val) && val >= min && val <= max
+	}
+}
+
+// FloatMin 浮点值不小于min
+func FloatMin(min float64) FloatExecutor {
+	return func(val float64) bool {
+		return Finite(val) && val >= min
+	}
+}
+
+// FloatMax 浮点值不大于max
+func FloatMax(max float64) FloatExecutor {
+	return func(val float64) bool {
+		return Finite(val) && val <= max
+	}
+}
+
+// Positive 大于0
+func Positive(val float64) bool {
+	return Finite(val) && val > 0
+}
+
+// NonNegative 大于等于0
+func NonNegative(val float64) bool {
+	return Finite(val) && val >= 0
+}
+
+// MultipleOf 为step的整数倍，val及step按其最短十进制表示精确计算，如 0.3 为 0.05 的6倍
+func MultipleOf(step float64) FloatExecutor {
+	var s *big.Rat
+	if Finite(step) && step != 0 {
+		s = decimalRat(step)
+	}
+	return func(val float64) bool {
+		if !Finite(val) || s == nil {
+			return false
+		}
+		return new(big.Rat).Quo(decimalRat(val), s).IsInt()
+	}
+}
+
+// decimalRat 浮点数的最短十进制表示转为精确分数
+func decimalRat(val float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(val, 'g', -1, 64))
+	return r
+}
+
+// MaxDecimals 小数位数不超过n，按浮点数的最短十进制表示计算
+func MaxDecimals(n int) FloatExecutor {
+	return func(val float64) bool {
+		if !Finite(val) {
+			return false
+		}
+		str := strconv.FormatFloat(val, 'f', -1, 64)
+		if idx := strings.IndexByte(str, '.'); idx >= 0 {
+			return len(str)-idx-1 <= n
+		}
+		return true
+	}
+}
+
 // EnumString 字符串枚举
 func EnumString(enums []string) StringExecutor {
 	return func(val string) bool {
@@ -1,5 +1,7 @@
 module github.com/rumis/govalidate
 
-go 1.16
+go 1.18
 
 require github.com/forPelevin/gomoji v1.1.3
+
+require github.com/rivo/uniseg v0.2.0 // indirect
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+// ParseAcceptLanguage 解析Accept-Language请求头，按q值从高到低返回语言，q值相同时保持原顺序
+// q参数可位于其他参数之后，如 en;level=1;q=0.5，q=0及q值格式错误的语言会被忽略，通配符为 *
+func ParseAcceptLanguage(header string) []string {
+	type tag struct {
+		lang string
+		q    float64
+	}
+	tags := make([]tag, 0, 4)
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		params := strings.Split(part, ";")
+		lang, q, valid := strings.TrimSpace(params[0]), 1.0, true
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") && !strings.HasPrefix(param, "Q=") {
+				continue
+			}
+			val, err := strconv.ParseFloat(strings.TrimSpace(param[2:]), 64)
+			if err != nil || val < 0 || val > 1 {
+				valid = false
+				break
+			}
+			q = val
+		}
+		if !valid || lang == "" || q == 0 {
+			continue
+		}
+		tags = append(tags, tag{lang: lang, q: q})
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+	langs := make([]string, len(tags))
+	for idx, t := range tags {
+		langs[idx] = t.lang
+	}
+	return langs
+}
+
+// Match 按Accept-Language请求头选择已加载的语言，均不匹配时返回默认语言
+// 依次尝试完全匹配、主语言匹配（如 en-US 匹配 en，zh 匹配 zh-CN），通配符匹配默认语言
+func (b *Bundle) Match(header string) string {
+	avail := b.Languages()
+	for _, lang := range ParseAcceptLanguage(header) {
+		if lang == "*" {
+			return b.defaultLang
+		}
+		if l, ok := matchLang(avail, lang); ok {
+			return l
+		}
+	}
+	return b.defaultLang
+}
+
+// WithAcceptLanguage 按Accept-Language请求头选择Localizer，并保存到context中key对应的位置
+// key应与校验引擎的Options.LocalizerKey一致，为nil时使用validator.GetLocalizerKey()
+func (b *Bundle) WithAcceptLanguage(ctx context.Context, header string, key interface{}) context.Context {
+	if key == nil {
+		key = validator.GetLocalizerKey()
+	}
+	return context.WithValue(ctx, key, b.Localizer(b.Match(header)))
+}
+
+// matchLang 在已加载的语言中匹配，avail需已排序
+func matchLang(avail []string, lang string) (string, bool) {
+	lang = strings.Replace(lang, "_", "-", -1)
+	for _, l := range avail {
+		if strings.EqualFold(l, lang) {
+			return l, true
+		}
+	}
+	base := validator.BaseLang(lang)
+	for _, l := range avail {
+		if strings.EqualFold(l, base) {
+			return l, true
+		}
+	}
+	for _, l := range avail {
+		if validator.BaseLang(l) == base {
+			return l, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+func TestAcceptLanguage(t *testing.T) {
+	langs := ParseAcceptLanguage("fr;q=0.5, zh-TW;q=0.8, en-US, de;q=0, *;q=0.1, ja;q=abc, ko;level=1;q=0.6, es;level=1")
+	if !reflect.DeepEqual(langs, []string{"en-US", "es", "zh-TW", "ko", "fr", "*"}) {
+		t.Fatal(langs)
+	}
+
+	b := NewBundle("en")
+	b.AddMessages("en", map[string]string{"age.error": "invalid age"})
+	b.AddMessages("zh-CN", map[string]string{"age.error": "年龄不合法"})
+	b.AddMessages("zh-TW", map[string]string{"age.error": "年齡不合法"})
+	cases := map[string]string{
+		"":                          "en",
+		"zh-tw":                     "zh-TW",
+		"zh;q=0.9, en;q=0.8":        "zh-CN",
+		"en;q=0.5, zh-HK;q=0.9":     "zh-CN",
+		"fr, *;q=0.5":               "en",
+		"de, zh-CN;q=0.2, en;q=0.1": "zh-CN",
+	}
+	for header, want := range cases {
+		if got := b.Match(header); got != want {
+			t.Errorf("%q: got %s, want %s", header, got, want)
+		}
+	}
+
+	ctx := b.WithAcceptLanguage(context.Background(), "zh-TW,zh;q=0.9", nil)
+	rules := validator.NewMultiLangFilter("age", []validator.Validator{validator.Int()}, "age.error", 0)
+	ferr := validator.ValidateFilter(ctx, rules, map[string]interface{}{"age": "x"}, map[string]interface{}{})
+	if ferr == nil || ferr.Msg != "年齡不合法" {
+		t.Fatal(ferr)
+	}
+
+	// 使用校验配置中的LocalizerKey
+	type localizerKey struct{}
+	ctx = b.WithAcceptLanguage(context.Background(), "zh-CN", localizerKey{})
+	ctx = validator.WithConfig(ctx, &validator.Config{LocalizerKey: localizerKey{}})
+	ferr = validator.ValidateFilter(ctx, rules, map[string]interface{}{"age": "x"}, map[string]interface{}{})
+	if ferr == nil || ferr.Msg != "年龄不合法" {
+		t.Fatal(ferr)
+	}
+}
@@ -0,0 +1,279 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rumis/govalidate/utils"
+	"github.com/rumis/govalidate/validator"
+)
+
+// message 单条消息，按数量选择复数形式
+type message struct {
+	Zero  string `json:"zero"`
+	One   string `json:"one"`
+	Other string `json:"other"`
+}
+
+// Bundle 多语言消息包，按语言加载消息并创建Localizer
+type Bundle struct {
+	mu          sync.RWMutex
+	defaultLang string
+	messages    map[string]map[string]message
+	fallbacks   map[string][]string
+	onMissing   func(lang string, id string)
+}
+
+// NewBundle 创建消息包，defaultLang为所有回退链的最后一环
+func NewBundle(defaultLang string) *Bundle {
+	return &Bundle{
+		defaultLang: defaultLang,
+		messages:    make(map[string]map[string]message),
+		fallbacks:   make(map[string][]string),
+	}
+}
+
+// DefaultLang 默认语言
+func (b *Bundle) DefaultLang() string {
+	return b.defaultLang
+}
+
+// AddMessages 添加消息，已存在的消息ID会被覆盖
+func (b *Bundle) AddMessages(lang string, msgs map[string]string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	set := b.langMessages(lang)
+	for id, text := range msgs {
+		set[id] = message{Other: text}
+	}
+}
+
+// LoadJSON 加载JSON格式的消息
+//
+//	{
+//	    "age.error": "{field}必须为整数",
+//	    "cart.items": {"zero": "购物车为空", "one": "购物车中有1件商品", "other": "购物车中有{count}件商品"}
+//	}
+func (b *Bundle) LoadJSON(lang string, data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("i18n: load %s: %w", lang, err)
+	}
+	msgs := make(map[string]message, len(raw))
+	for id, val := range raw {
+		var text string
+		if err := json.Unmarshal(val, &text); err == nil {
+			msgs[id] = message{Other: text}
+			continue
+		}
+		var msg message
+		dec := json.NewDecoder(bytes.NewReader(val))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&msg); err != nil {
+			return fmt.Errorf("i18n: load %s: message %s: %w", lang, id, err)
+		}
+		if msg.Other == "" {
+			return fmt.Errorf("i18n: load %s: message %s: other is empty", lang, id)
+		}
+		msgs[id] = msg
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	set := b.langMessages(lang)
+	for id, msg := range msgs {
+		set[id] = msg
+	}
+	return nil
+}
+
+// LoadFile 加载JSON格式的消息文件，文件名为语言，如 zh-CN.json
+func (b *Bundle) LoadFile(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("i18n: %w", err)
+	}
+	return b.LoadJSON(fileLang(filepath.Base(filename)), data)
+}
+
+// LoadFS 加载目录下所有JSON格式的消息文件，可用于embed.FS
+func (b *Bundle) LoadFS(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return fmt.Errorf("i18n: %w", err)
+	}
+	for _, name := range files {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("i18n: %w", err)
+		}
+		if err := b.LoadJSON(fileLang(path.Base(name)), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetFallback 设置语言的回退链，如 zh-TW -> zh-CN
+// 未设置时回退到同一主语言的其他地区，最后回退到默认语言
+func (b *Bundle) SetFallback(lang string, chain ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fallbacks[lang] = chain
+}
+
+// OnMissing 设置消息缺失时的回调，lang为Localizer的语言
+// 内置错误信息（validator.MessagePrefix开头的消息ID）缺失时使用内置文案，不触发回调
+func (b *Bundle) OnMissing(fn func(lang string, id string)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.onMissing = fn
+}
+
+// Languages 已加载的语言
+func (b *Bundle) Languages() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	langs := make([]string, 0, len(b.messages))
+	for lang := range b.messages {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// MissingKeys 各语言相对默认语言缺失的消息ID，不考虑回退
+func (b *Bundle) MissingKeys() map[string][]string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	missing := make(map[string][]string)
+	for lang, set := range b.messages {
+		if lang == b.defaultLang {
+			continue
+		}
+		for id := range b.messages[b.defaultLang] {
+			if _, ok := set[id]; !ok {
+				missing[lang] = append(missing[lang], id)
+			}
+		}
+		sort.Strings(missing[lang])
+	}
+	return missing
+}
+
+// Localizer 创建指定语言的Localizer，回退链在创建时确定
+func (b *Bundle) Localizer(lang string) *Localizer {
+	return &Localizer{
+		bundle: b,
+		lang:   lang,
+		chain:  b.chain(lang),
+	}
+}
+
+// chain 语言的回退链，包含语言本身
+func (b *Bundle) chain(lang string) []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	chain := make([]string, 0, 4)
+	seen := make(map[string]bool, 4)
+	add := func(l string) {
+		if l != "" && !seen[l] {
+			seen[l] = true
+			chain = append(chain, l)
+		}
+	}
+	add(lang)
+	if fallbacks, ok := b.fallbacks[lang]; ok {
+		for _, l := range fallbacks {
+			add(l)
+		}
+	} else {
+		langs := make([]string, 0, len(b.messages))
+		for l := range b.messages {
+			if l != lang && validator.BaseLang(l) == validator.BaseLang(lang) {
+				langs = append(langs, l)
+			}
+		}
+		sort.Strings(langs)
+		for _, l := range langs {
+			add(l)
+		}
+	}
+	add(b.defaultLang)
+	return chain
+}
+
+// langMessages 语言的消息集合，调用方需持有写锁
+func (b *Bundle) langMessages(lang string) map[string]message {
+	set, ok := b.messages[lang]
+	if !ok {
+		set = make(map[string]message)
+		b.messages[lang] = set
+	}
+	return set
+}
+
+// lookup 按回退链查找消息
+func (b *Bundle) lookup(chain []string, id string) (message, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, lang := range chain {
+		if msg, ok := b.messages[lang][id]; ok {
+			return msg, true
+		}
+	}
+	return message{}, false
+}
+
+// Localizer 指定语言的翻译器，实现 validator.ArgsLocalizer
+type Localizer struct {
+	bundle *Bundle
+	lang   string
+	chain  []string
+}
+
+// Language 语言
+func (l *Localizer) Language() string {
+	return l.lang
+}
+
+// Localize 翻译消息，消息不存在时返回消息ID
+func (l *Localizer) Localize(id string) string {
+	return l.LocalizeWith(id, nil)
+}
+
+// LocalizeWith 翻译消息并替换占位符，消息不存在时返回消息ID
+// args中的count用于选择复数形式
+func (l *Localizer) LocalizeWith(id string, args map[string]interface{}) string {
+	msg, ok := l.bundle.lookup(l.chain, id)
+	if !ok {
+		l.bundle.mu.RLock()
+		fn := l.bundle.onMissing
+		l.bundle.mu.RUnlock()
+		if fn != nil && !strings.HasPrefix(id, validator.MessagePrefix) {
+			fn(l.lang, id)
+		}
+		return id
+	}
+	tpl := msg.Other
+	if count, ok := utils.GetIntValue(args["count"]); ok {
+		if count == 0 && msg.Zero != "" {
+			tpl = msg.Zero
+		} else if count == 1 && msg.One != "" {
+			tpl = msg.One
+		}
+	}
+	return validator.FormatMessage(tpl, args)
+}
+
+// fileLang 由文件名得到语言，如 zh-CN.json 为 zh-CN
+func fileLang(name string) string {
+	return strings.TrimSuffix(name, path.Ext(name))
+}
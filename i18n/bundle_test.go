@@ -0,0 +1,72 @@
+package i18n
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+func TestBundle(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/en.json":    {Data: []byte(`{"age.error": "{field} must be an integer", "cart": {"zero": "empty", "one": "1 item", "other": "{count} items"}, "name.error": "invalid name"}`)},
+		"locales/zh-CN.json": {Data: []byte(`{"age.error": "{field}必须为整数", "govalidate.required": "请填写{field}"}`)},
+		"locales/zh-TW.json": {Data: []byte(`{"age.error": "{field}必須為整數"}`)},
+	}
+	b := NewBundle("en")
+	if err := b.LoadFS(fsys, "locales"); err != nil {
+		t.Fatal(err)
+	}
+	if langs := b.Languages(); !reflect.DeepEqual(langs, []string{"en", "zh-CN", "zh-TW"}) {
+		t.Fatal(langs)
+	}
+	b.SetFallback("zh-HK", "zh-TW", "zh-CN")
+	var missing []string
+	b.OnMissing(func(lang string, id string) {
+		missing = append(missing, lang+":"+id)
+	})
+
+	cases := []struct {
+		lang string
+		id   string
+		args map[string]interface{}
+		want string
+	}{
+		{"zh-CN", "age.error", map[string]interface{}{"field": "age"}, "age必须为整数"},
+		{"zh-HK", "age.error", map[string]interface{}{"field": "age"}, "age必須為整數"},
+		{"zh-HK", "govalidate.required", map[string]interface{}{"field": "age"}, "请填写age"},
+		{"zh-SG", "govalidate.required", map[string]interface{}{"field": "age"}, "请填写age"},
+		{"fr", "name.error", nil, "invalid name"},
+		{"en", "cart", map[string]interface{}{"count": 0}, "empty"},
+		{"en", "cart", map[string]interface{}{"count": 1}, "1 item"},
+		{"en", "cart", map[string]interface{}{"count": 3}, "3 items"},
+		{"en", "nope", nil, "nope"},
+		{"en", "govalidate.required", nil, "govalidate.required"},
+	}
+	for _, c := range cases {
+		if got := b.Localizer(c.lang).LocalizeWith(c.id, c.args); got != c.want {
+			t.Errorf("%s %s: got %q, want %q", c.lang, c.id, got, c.want)
+		}
+	}
+	if !reflect.DeepEqual(missing, []string{"en:nope"}) {
+		t.Fatal(missing)
+	}
+	if keys := b.MissingKeys(); !reflect.DeepEqual(keys["zh-TW"], []string{"cart", "name.error"}) {
+		t.Fatal(keys)
+	}
+
+	// 通过context key使用
+	ctx := context.WithValue(context.Background(), validator.GetLocalizerKey(), b.Localizer("zh-CN"))
+	res := map[string]interface{}{}
+	ferr := validator.ValidateFilter(ctx, validator.NewMultiLangFilter("age", []validator.Validator{validator.Required()}, "", 0), map[string]interface{}{}, res)
+	if ferr == nil || ferr.Msg != "请填写age" {
+		t.Fatal(ferr)
+	}
+	params := map[string]interface{}{"age": "x"}
+	ferr = validator.ValidateFilter(ctx, validator.NewMultiLangFilter("age", []validator.Validator{validator.Int("age.error")}, "", 0), params, res)
+	if ferr == nil || ferr.Msg != "age必须为整数" {
+		t.Fatal(ferr)
+	}
+}
@@ -0,0 +1,491 @@
+package govalidate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rumis/govalidate/utils"
+	"github.com/rumis/govalidate/validator"
+)
+
+// RuleBuilder 根据规则参数构建校验规则
+type RuleBuilder func(args []string, emsg ...string) (validator.Validator, error)
+
+// RuleSet 规则定义文件
+type RuleSet struct {
+	Filters []validator.Filter
+	// Messages 语言 -> 消息ID -> 消息内容
+	Messages map[string]map[string]string
+}
+
+// ruleFile 规则定义文件格式
+type ruleFile struct {
+	Messages map[string]map[string]string `json:"messages"`
+	Fields   []ruleField                  `json:"fields"`
+}
+
+// ruleField 单个参数的规则
+type ruleField struct {
+	Key   string            `json:"key"`
+	Label string            `json:"label"`
+	Rules []json.RawMessage `json:"rules"`
+	Msg   string            `json:"msg"`
+	Code  int32             `json:"code"`
+}
+
+// ruleItem 对象形式的规则
+type ruleItem struct {
+	Name string        `json:"name"`
+	Args []interface{} `json:"args"`
+	Msg  string        `json:"msg"`
+	Code int32         `json:"code"`
+}
+
+var ruleBuilders = map[string]RuleBuilder{
+	"required":        noArgRule(validator.Required),
+	"int":             noArgRule(validator.Int),
+	"int64":           noArgRule(validator.Int64),
+	"uint64":          noArgRule(validator.Uint64),
+	"int32":           noArgRule(validator.Int32),
+	"uint32":          noArgRule(validator.Uint32),
+	"float":           noArgRule(validator.Float),
+	"string":          noArgRule(validator.String),
+	"email":           noArgRule(validator.Email),
+	"url":             noArgRule(validator.Url),
+	"phone":           noArgRule(validator.Phone),
+	"ipv4":            noArgRule(validator.Ipv4),
+	"date":            noArgRule(validator.Date),
+	"datetime":        noArgRule(validator.Datetime),
+	"rfc3339":         noArgRule(validator.DatetimeRFC3339),
+	"dotint":          noArgRule(validator.DotInt),
+	"positive":        noArgRule(validator.Positive),
+	"notinfuture":     noArgRule(validator.NotInFuture),
+	"notinpast":       noArgRule(validator.NotInPast),
+	"nonnegative":     noArgRule(validator.NonNegative),
+	"intslice":        sliceRule(validator.IntSlice),
+	"stringslice":     sliceRule(validator.StringSlice),
+	"floatslice":      sliceRule(validator.FloatSlice),
+	"omitempty":       fixedRule(validator.OmitEmpty),
+	"emptystring":     fixedRule(validator.EmptyString),
+	"dotint2slice":    fixedRule(validator.Dotint2Slice),
+	"dotint64toslice": fixedRule(validator.Dotint64ToSlice),
+	"dottoslice":      fixedRule(validator.DotToSlice),
+	"removeemoji":     fixedRule(validator.RemoveEmoji),
+	"xss":             fixedRule(validator.XSS),
+	"optional": func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(args) == 0 {
+			return validator.Optional(), nil
+		}
+		return validator.Optional(args[0]), nil
+	},
+	"boolean": func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(args) == 0 {
+			return validator.Boolean(emsg...), nil
+		}
+		switch strings.ToLower(args[0]) {
+		case "std":
+			return validator.BooleanWith(utils.StdBoolVocab, emsg...), nil
+		case "ext":
+			return validator.BooleanWith(utils.ExtBoolVocab, emsg...), nil
+		}
+		return nil, fmt.Errorf("unknown boolean vocabulary %q", args[0])
+	},
+	"time": func(args []string, emsg ...string) (validator.Validator, error) {
+		layouts := make([]string, len(args))
+		for idx, arg := range args {
+			if layout, ok := timeLayouts[strings.ToLower(arg)]; ok {
+				arg = layout
+			}
+			layouts[idx] = arg
+		}
+		return validator.TimeWith(validator.TimeOptions{Layouts: layouts}, emsg...), nil
+	},
+	"before": func(args []string, emsg ...string) (validator.Validator, error) {
+		t, err := ruleTimeArg(args)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Before(t, emsg...), nil
+	},
+	"after": func(args []string, emsg ...string) (validator.Validator, error) {
+		t, err := ruleTimeArg(args)
+		if err != nil {
+			return nil, err
+		}
+		return validator.After(t, emsg...), nil
+	},
+	"withinlast": func(args []string, emsg ...string) (validator.Validator, error) {
+		d, err := ruleDurationArg(args)
+		if err != nil {
+			return nil, err
+		}
+		return validator.WithinLast(d, emsg...), nil
+	},
+	"withinnext": func(args []string, emsg ...string) (validator.Validator, error) {
+		d, err := ruleDurationArg(args)
+		if err != nil {
+			return nil, err
+		}
+		return validator.WithinNext(d, emsg...), nil
+	},
+	// daterange:开始KEY,结束KEY[,最大间隔[,最小间隔]]，按天的闭区间计算间隔，如 daterange:start_date,end_date,31d
+	"daterange": func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(args) < 2 || len(args) > 4 {
+			return nil, fmt.Errorf("expect 2 to 4 arguments, got %d", len(args))
+		}
+		o := validator.DateRangeOptions{Start: args[0], End: args[1], Inclusive: true}
+		var err error
+		if len(args) > 2 {
+			if o.MaxSpan, err = ruleDurationArg(args[2:3]); err != nil {
+				return nil, err
+			}
+		}
+		if len(args) > 3 {
+			if o.MinSpan, err = ruleDurationArg(args[3:4]); err != nil {
+				return nil, err
+			}
+		}
+		return validator.DateRange(o, emsg...), nil
+	},
+	"resetkey": func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expect 1 argument, got %d", len(args))
+		}
+		return validator.ResetKey(args[0]), nil
+	},
+	"paginate": func(args []string, emsg ...string) (validator.Validator, error) {
+		return validator.Paginate(args...), nil
+	},
+	"length": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleIntArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Length(vals[0], vals[1], emsg...), nil
+	},
+	"between": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleIntArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Between(vals[0], vals[1], emsg...), nil
+	},
+	"between64": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleInt64Args(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Between64(vals[0], vals[1], emsg...), nil
+	},
+	"betweenuint64": func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("expect 2 arguments, got %d", len(args))
+		}
+		min, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer argument %q", args[0])
+		}
+		max, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer argument %q", args[1])
+		}
+		return validator.BetweenUint64(min, max, emsg...), nil
+	},
+	"floatbetween": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleFloatArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validator.FloatBetween(vals[0], vals[1], emsg...), nil
+	},
+	"min": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleFloatArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Min(vals[0], emsg...), nil
+	},
+	"max": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleFloatArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Max(vals[0], emsg...), nil
+	},
+	"multipleof": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleFloatArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return validator.MultipleOf(vals[0], emsg...), nil
+	},
+	"maxdecimals": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleIntArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return validator.MaxDecimals(vals[0], emsg...), nil
+	},
+	"decimal": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleIntArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Decimal(validator.DecimalOptions{Precision: vals[0], Scale: vals[1]}, emsg...), nil
+	},
+	"money": func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expect 1 argument, got %d", len(args))
+		}
+		if _, ok := validator.CurrencyMinorUnits(args[0]); !ok {
+			return nil, fmt.Errorf("unknown currency %q", args[0])
+		}
+		return validator.Money(args[0], emsg...), nil
+	},
+	"maxdot": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleIntArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return validator.Maxdot(vals[0], emsg...), nil
+	},
+	"enumint": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleIntArgs(args, -1)
+		if err != nil {
+			return nil, err
+		}
+		return validator.EnumInt(vals, emsg...), nil
+	},
+	"enumint64": func(args []string, emsg ...string) (validator.Validator, error) {
+		vals, err := ruleInt64Args(args, -1)
+		if err != nil {
+			return nil, err
+		}
+		return validator.EnumInt64(vals, emsg...), nil
+	},
+	"enumstring": func(args []string, emsg ...string) (validator.Validator, error) {
+		return validator.EnumString(args, emsg...), nil
+	},
+	"regex": func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expect 1 argument, got %d", len(args))
+		}
+		return validator.Regex(args[0], emsg...), nil
+	},
+}
+
+// timeLayouts time规则参数中可使用的格式名称
+var timeLayouts = map[string]string{
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+	"date":        "2006-01-02",
+	"datetime":    "2006-01-02 15:04:05",
+}
+
+// RegisterRule 注册规则，同名时覆盖内置规则
+// 应在初始化阶段调用
+func RegisterRule(name string, builder RuleBuilder) {
+	ruleBuilders[strings.ToLower(name)] = builder
+}
+
+// ParseRules 解析JSON格式的规则定义
+//
+//	{
+//	    "messages": {"en": {"age": "Age", "age.error": "{field} must be an integer"}},
+//	    "fields": [
+//	        {"key": "age", "label": "age", "rules": ["required", "int", "between:1,120"], "msg": "age.error", "code": 10001},
+//	        {"key": "code", "rules": [{"name": "regex", "args": ["^[0-9]{1,6}$"], "msg": "code.error", "code": 10002}]}
+//	    ]
+//	}
+//
+// 字符串形式的规则为 名称:参数1,参数2，regex的参数不做拆分
+// 错误信息按多语言处理，context中存在Localizer时以错误信息作为消息ID翻译
+// 对象形式的规则可设置code，优先于参数的code
+// label为参数显示名称的消息ID，翻译后替换错误信息中的{field}
+func ParseRules(data []byte) (*RuleSet, error) {
+	var file ruleFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("govalidate: parse rules: %w", err)
+	}
+	set := &RuleSet{
+		Filters:  make([]validator.Filter, 0, len(file.Fields)),
+		Messages: file.Messages,
+	}
+	for _, field := range file.Fields {
+		if field.Key == "" {
+			return nil, fmt.Errorf("govalidate: parse rules: field key is empty")
+		}
+		rules := make([]validator.Validator, 0, len(field.Rules))
+		for _, raw := range field.Rules {
+			rule, err := parseRule(raw)
+			if err != nil {
+				return nil, fmt.Errorf("govalidate: parse rules: field %s: %w", field.Key, err)
+			}
+			rules = append(rules, rule)
+		}
+		set.Filters = append(set.Filters, validator.NewMultiLangFilter(field.Key, rules, field.Msg, field.Code).WithLabel(field.Label))
+	}
+	return set, nil
+}
+
+// parseRule 解析单条规则
+func parseRule(raw json.RawMessage) (validator.Validator, error) {
+	var item ruleItem
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		name, args := str, ""
+		if idx := strings.Index(str, ":"); idx >= 0 {
+			name, args = str[:idx], str[idx+1:]
+		}
+		item.Name = name
+		if args != "" {
+			if strings.EqualFold(name, "regex") {
+				item.Args = []interface{}{args}
+			} else {
+				for _, arg := range strings.Split(args, ",") {
+					item.Args = append(item.Args, strings.TrimSpace(arg))
+				}
+			}
+		}
+	} else {
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		if err := dec.Decode(&item); err != nil {
+			return nil, err
+		}
+	}
+	builder, ok := ruleBuilders[strings.ToLower(item.Name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown rule %q", item.Name)
+	}
+	args := make([]string, len(item.Args))
+	for idx, arg := range item.Args {
+		sarg, ok := utils.GetStringValue(arg)
+		if !ok {
+			return nil, fmt.Errorf("rule %s: invalid argument %v", item.Name, arg)
+		}
+		args[idx] = sarg
+	}
+	var emsg []string
+	if item.Msg != "" {
+		emsg = append(emsg, item.Msg)
+	}
+	rule, err := builder(args, emsg...)
+	if err != nil {
+		return nil, fmt.Errorf("rule %s: %w", item.Name, err)
+	}
+	if item.Code != 0 {
+		rule = validator.WithCode(item.Code, rule)
+	}
+	return rule, nil
+}
+
+// noArgRule 无参数的校验规则
+func noArgRule(fn func(emsg ...string) validator.Validator) RuleBuilder {
+	return func(args []string, emsg ...string) (validator.Validator, error) {
+		return fn(emsg...), nil
+	}
+}
+
+// fixedRule 无参数且无错误信息的规则
+func fixedRule(fn func() validator.Validator) RuleBuilder {
+	return func(args []string, emsg ...string) (validator.Validator, error) {
+		return fn(), nil
+	}
+}
+
+// sliceRule 数组规则，仅支持错误信息
+func sliceRule(fn func(msgExecutor ...interface{}) validator.Validator) RuleBuilder {
+	return func(args []string, emsg ...string) (validator.Validator, error) {
+		if len(emsg) > 0 {
+			return fn(emsg[0]), nil
+		}
+		return fn(), nil
+	}
+}
+
+// ruleIntArgs 解析整形参数，n小于0时不限制个数
+func ruleIntArgs(args []string, n int) ([]int, error) {
+	if n >= 0 && len(args) != n {
+		return nil, fmt.Errorf("expect %d arguments, got %d", n, len(args))
+	}
+	vals := make([]int, len(args))
+	for idx, arg := range args {
+		v, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer argument %q", arg)
+		}
+		vals[idx] = v
+	}
+	return vals, nil
+}
+
+// ruleInt64Args 解析int64参数，n小于0时不限制个数
+func ruleInt64Args(args []string, n int) ([]int64, error) {
+	if n >= 0 && len(args) != n {
+		return nil, fmt.Errorf("expect %d arguments, got %d", n, len(args))
+	}
+	vals := make([]int64, len(args))
+	for idx, arg := range args {
+		v, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer argument %q", arg)
+		}
+		vals[idx] = v
+	}
+	return vals, nil
+}
+
+// ruleFloatArgs 解析浮点参数
+func ruleFloatArgs(args []string, n int) ([]float64, error) {
+	if len(args) != n {
+		return nil, fmt.Errorf("expect %d arguments, got %d", n, len(args))
+	}
+	vals := make([]float64, len(args))
+	for idx, arg := range args {
+		v, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number argument %q", arg)
+		}
+		vals[idx] = v
+	}
+	return vals, nil
+}
+
+// ruleTimeArg 解析时间参数，格式同validator.DefaultTimeLayouts，不含时区信息时为validator.DefaultLocation
+func ruleTimeArg(args []string) (time.Time, error) {
+	if len(args) != 1 {
+		return time.Time{}, fmt.Errorf("expect 1 argument, got %d", len(args))
+	}
+	for _, layout := range validator.DefaultTimeLayouts {
+		if t, err := time.ParseInLocation(layout, args[0], validator.DefaultLocation); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time argument %q", args[0])
+}
+
+// ruleDurationArg 解析时长参数，格式同time.ParseDuration，另支持天数如 90d
+func ruleDurationArg(args []string) (time.Duration, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("expect 1 argument, got %d", len(args))
+	}
+	if days := strings.TrimSuffix(args[0], "d"); days != args[0] {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration argument %q", args[0])
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(args[0])
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration argument %q", args[0])
+	}
+	return d, nil
+}
@@ -0,0 +1,293 @@
+package govalidate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+// FieldDef 值类型为T的参数定义
+type FieldDef[T any] struct {
+	filter validator.Filter
+}
+
+// Conv 结果类型为T的类型转换规则，Field据此在编译期确定参数类型
+type Conv[T any] struct {
+	pre  []validator.Validator
+	conv validator.Validator
+}
+
+// Convert 使用自定义规则转换为T，规则的结果类型在校验时检查
+func Convert[T any](conv validator.Validator) Conv[T] {
+	return Conv[T]{conv: conv}
+}
+
+// AsInt 同validator.Int
+func AsInt(emsg ...string) Conv[int] {
+	return Convert[int](validator.Int(emsg...))
+}
+
+// AsInt32 同validator.Int32
+func AsInt32(emsg ...string) Conv[int32] {
+	return Convert[int32](validator.Int32(emsg...))
+}
+
+// AsInt64 同validator.Int64
+func AsInt64(emsg ...string) Conv[int64] {
+	return Convert[int64](validator.Int64(emsg...))
+}
+
+// AsUint32 同validator.Uint32
+func AsUint32(emsg ...string) Conv[uint32] {
+	return Convert[uint32](validator.Uint32(emsg...))
+}
+
+// AsUint64 同validator.Uint64
+func AsUint64(emsg ...string) Conv[uint64] {
+	return Convert[uint64](validator.Uint64(emsg...))
+}
+
+// AsFloat 同validator.Float
+func AsFloat(emsg ...string) Conv[float64] {
+	return Convert[float64](validator.Float(emsg...))
+}
+
+// AsString 同validator.String
+func AsString(emsg ...string) Conv[string] {
+	return Convert[string](validator.String(emsg...))
+}
+
+// AsBoolean 同validator.Boolean
+func AsBoolean(emsg ...string) Conv[bool] {
+	return Convert[bool](validator.Boolean(emsg...))
+}
+
+// AsTime 同validator.Time
+func AsTime(layouts ...string) Conv[time.Time] {
+	return Convert[time.Time](validator.Time(layouts...))
+}
+
+// Required 转换前要求参数存在，同validator.Required
+func (c Conv[T]) Required(emsg ...string) Conv[T] {
+	c.pre = append(c.pre[:len(c.pre):len(c.pre)], validator.Required(emsg...))
+	return c
+}
+
+// Optional 参数可选，同validator.Optional，默认值同样需要能转换为T
+func (c Conv[T]) Optional(defaultVal ...interface{}) Conv[T] {
+	c.pre = append(c.pre[:len(c.pre):len(c.pre)], validator.Optional(defaultVal...))
+	return c
+}
+
+// OmitEmpty 参数不存在时跳过，同validator.OmitEmpty
+func (c Conv[T]) OmitEmpty() Conv[T] {
+	c.pre = append(c.pre[:len(c.pre):len(c.pre)], validator.OmitEmpty())
+	return c
+}
+
+// Field 定义值类型为T的参数，T由conv确定，如 Field("age", AsInt().Required(), validator.Between(1, 120)) 为FieldDef[int]
+// 类型不符时无法编译，如 var age FieldDef[int64] = Field("age", AsInt())
+// conv之后的规则不应改变参数类型，结果类型仍会在校验时检查；参数不存在（结果为nil）时不做类型检查
+func Field[T any](key string, conv Conv[T], rules ...validator.Validator) FieldDef[T] {
+	chain := make([]validator.Validator, 0, len(conv.pre)+len(rules)+1)
+	chain = append(chain, conv.pre...)
+	chain = append(chain, conv.conv)
+	chain = append(chain, rules...)
+	return FieldDef[T]{}.WithFilter(NewFilter(key, chain))
+}
+
+// WithFilter 使用自定义Filter，如设置了错误信息或多语言的Filter，参数KEY取filter的KEY
+// filter的规则链由调用方提供，结果类型在校验时检查，规则链末尾自动追加类型检查
+func (f FieldDef[T]) WithFilter(filter validator.Filter) FieldDef[T] {
+	f.filter = typedFilter[T]{Filter: filter}
+	return f
+}
+
+// Key 参数KEY，即Filter的KEY
+func (f FieldDef[T]) Key() string {
+	return f.filter.Key(context.Background())
+}
+
+// Filter 参数的Filter，可与其他Filter一起用于Validate等函数
+func (f FieldDef[T]) Filter() validator.Filter {
+	return f.filter
+}
+
+// Get 从校验结果中获取参数值，参数不存在时返回T的零值及false
+func (f FieldDef[T]) Get(res map[string]interface{}) (T, bool) {
+	return Get[T](res, f.Key())
+}
+
+// Get 从校验结果中获取类型为T的值
+func Get[T any](res map[string]interface{}, key string) (T, bool) {
+	v, ok := res[key].(T)
+	return v, ok
+}
+
+// typedFilter 在规则链末尾追加类型检查的Filter，错误信息模式、显示名称及类型模式沿用原Filter
+// 结果KEY固定为Filter的KEY，规则链中的ResetKey不生效，保证FieldDef.Get读取的位置与校验结果一致
+type typedFilter[T any] struct {
+	validator.Filter
+}
+
+// Rules 原Filter的规则及类型检查
+func (f typedFilter[T]) Rules(ctx context.Context) []validator.Validator {
+	rules := f.Filter.Rules(ctx)
+	chain := make([]validator.Validator, 0, len(rules)+1)
+	chain = append(chain, rules...)
+	return append(chain, typed[T](f.Filter.Key(ctx)))
+}
+
+// FormatErrMsg 获取错误信息并替换占位符
+func (f typedFilter[T]) FormatErrMsg(ctx context.Context, args map[string]interface{}) string {
+	if ff, ok := f.Filter.(interface {
+		FormatErrMsg(ctx context.Context, args map[string]interface{}) string
+	}); ok {
+		return ff.FormatErrMsg(ctx, args)
+	}
+	return f.Filter.ErrMsg(ctx)
+}
+
+// MessageMode 错误信息模式
+func (f typedFilter[T]) MessageMode(ctx context.Context) validator.MessageMode {
+	if m, ok := f.Filter.(validator.MessageModer); ok {
+		return m.MessageMode(ctx)
+	}
+	return validator.MM_DEFAULT
+}
+
+// Label 参数显示名称
+func (f typedFilter[T]) Label(ctx context.Context) string {
+	if l, ok := f.Filter.(validator.Labeler); ok {
+		return l.Label(ctx)
+	}
+	return ""
+}
+
+// TypeMode 类型模式
+func (f typedFilter[T]) TypeMode(ctx context.Context) validator.TypeMode {
+	if m, ok := f.Filter.(validator.TypeModer); ok {
+		return m.TypeMode(ctx)
+	}
+	return validator.TM_DEFAULT
+}
+
+// typed 检查规则链的结果类型，并将结果KEY恢复为key
+func typed[T any](key string) validator.Validator {
+	return func(opts *validator.ValidateOptions) validator.ValidateResult {
+		opts.Key = key
+		if opts.Value == nil {
+			return validator.Succ()
+		}
+		if _, ok := opts.Value.(T); !ok {
+			var zero T
+			return validator.FailMsg(nil, "type", map[string]interface{}{"type": fmt.Sprintf("%T", zero)})
+		}
+		return validator.Succ()
+	}
+}
+
+// SchemaField 结构体T的字段绑定
+type SchemaField[T any] struct {
+	filter validator.Filter
+	set    func(dst *T, res map[string]interface{})
+}
+
+// Bind 将参数绑定到结构体T的字段，set在校验通过且参数存在时调用
+//
+//	Bind(Field("age", AsInt().Required()), func(u *User, v int) { u.Age = v })
+func Bind[T any, V any](f FieldDef[V], set func(dst *T, val V)) SchemaField[T] {
+	return SchemaField[T]{
+		filter: f.filter,
+		set: func(dst *T, res map[string]interface{}) {
+			if v, ok := f.Get(res); ok {
+				set(dst, v)
+			}
+		},
+	}
+}
+
+// SchemaDef 结构体T的规则集，校验结果直接填充到结构体
+type SchemaDef[T any] struct {
+	engine  *Engine
+	filters []validator.Filter
+	fields  []SchemaField[T]
+}
+
+// Schema 定义结构体T的规则集
+func Schema[T any](fields ...SchemaField[T]) *SchemaDef[T] {
+	filters := make([]validator.Filter, len(fields))
+	for idx, f := range fields {
+		filters[idx] = f.filter
+	}
+	return &SchemaDef[T]{
+		engine:  defaultEngine,
+		filters: filters,
+		fields:  fields,
+	}
+}
+
+// WithEngine 使用指定的校验引擎
+func (s *SchemaDef[T]) WithEngine(e *Engine) *SchemaDef[T] {
+	c := *s
+	c.engine = e
+	return &c
+}
+
+// Filters 全部Filter
+func (s *SchemaDef[T]) Filters() []validator.Filter {
+	return s.filters
+}
+
+// Validate 校验参数并填充结构体，遇到首个错误时中断
+func (s *SchemaDef[T]) Validate(ctx context.Context, params map[string]interface{}) (T, *validator.FieldError) {
+	var dst T
+	res, ferr := s.engine.Check(ctx, params, s.filters)
+	if ferr != nil {
+		return dst, ferr
+	}
+	s.fill(&dst, res)
+	return dst, nil
+}
+
+// ValidateAll 校验全部参数并填充结构体，存在错误时结构体中仅包含校验通过的字段
+func (s *SchemaDef[T]) ValidateAll(ctx context.Context, params map[string]interface{}) (T, []*validator.FieldError) {
+	var dst T
+	res, errs := s.engine.ValidateAll(ctx, params, s.filters)
+	s.fill(&dst, res)
+	return dst, errs
+}
+
+// As 同Rule，用于Field定义类型为T的嵌套参数
+//
+//	Field("address", addressSchema.As().Required())
+func (s *SchemaDef[T]) As(emsg ...string) Conv[T] {
+	return Convert[T](s.Rule(emsg...))
+}
+
+// Rule 参数为对象，按规则集校验并将参数值替换为结构体T，用于嵌套结构
+func (s *SchemaDef[T]) Rule(emsg ...string) validator.Validator {
+	return func(opts *validator.ValidateOptions) validator.ValidateResult {
+		obj, ok := opts.Value.(map[string]interface{})
+		if !ok {
+			return validator.FailMsg(emsg, "object", nil)
+		}
+		res, ferr := validator.ValidateFilters(validator.WithPath(opts.Context(), opts.Path), obj, s.filters)
+		if ferr != nil {
+			return validator.NestedFail(ferr)
+		}
+		var dst T
+		s.fill(&dst, res)
+		opts.Value = dst
+		return validator.Succ()
+	}
+}
+
+// fill 将校验结果填充到结构体
+func (s *SchemaDef[T]) fill(dst *T, res map[string]interface{}) {
+	for _, f := range s.fields {
+		f.set(dst, res)
+	}
+}
@@ -0,0 +1,85 @@
+package govalidate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rumis/govalidate/validator"
+)
+
+type typedAddress struct {
+	City string
+	Zip  int64
+}
+
+type typedUser struct {
+	Name    string
+	Age     int
+	Score   float64
+	Address typedAddress
+}
+
+func TestTyped(t *testing.T) {
+	address := Schema(
+		Bind(Field("city", AsString().Required()), func(a *typedAddress, v string) { a.City = v }),
+		Bind(Field("zip", AsInt64().Optional()), func(a *typedAddress, v int64) { a.Zip = v }),
+	)
+	users := Schema(
+		Bind(Field("name", AsString().Required(), validator.Length(1, 10)), func(u *typedUser, v string) { u.Name = v }),
+		Bind(Field("age", AsInt().Required(), validator.Between(1, 120)), func(u *typedUser, v int) { u.Age = v }),
+		Bind(Field("score", AsFloat().OmitEmpty()), func(u *typedUser, v float64) { u.Score = v }),
+		Bind(Field("address", address.As().Required()), func(u *typedUser, v typedAddress) { u.Address = v }),
+	)
+
+	u, ferr := users.Validate(context.Background(), map[string]interface{}{
+		"name":    "tom",
+		"age":     "18",
+		"address": map[string]interface{}{"city": "Beijing", "zip": "100000"},
+	})
+	if ferr != nil {
+		t.Fatal(ferr)
+	}
+	if u != (typedUser{Name: "tom", Age: 18, Address: typedAddress{City: "Beijing", Zip: 100000}}) {
+		t.Fatalf("%+v", u)
+	}
+
+	_, ferr = users.Validate(context.Background(), map[string]interface{}{
+		"name":    "tom",
+		"age":     18,
+		"address": map[string]interface{}{"zip": 1},
+	})
+	if ferr == nil || ferr.Field != "address.city" {
+		t.Fatal(ferr)
+	}
+
+	u, errs := users.ValidateAll(context.Background(), map[string]interface{}{"name": "tom", "age": 0})
+	if len(errs) != 2 || u.Name != "tom" || u.Age != 0 {
+		t.Fatal(u, errs)
+	}
+
+	// 自定义转换规则的结果类型与T不一致
+	age := Field("age", Convert[int64](validator.Int()))
+	en := validator.WithConfig(context.Background(), &validator.Config{Lang: "en"})
+	res, ferr := Check(en, map[string]interface{}{"age": "1"}, []validator.Filter{age.Filter()})
+	if ferr == nil || ferr.Msg != "age must be of type int64" {
+		t.Fatal(res, ferr)
+	}
+	// 自定义Filter同样追加类型检查，并保留显示名称
+	age = Field("age", AsInt64()).WithFilter(WithLabel(NewFilter("age", []validator.Validator{validator.Int()}), "年龄"))
+	zh := validator.WithConfig(context.Background(), &validator.Config{Lang: "zh-CN"})
+	res, ferr = Check(zh, map[string]interface{}{"age": "1"}, []validator.Filter{age.Filter()})
+	if ferr == nil || ferr.Msg != "年龄的类型必须为int64" {
+		t.Fatal(res, ferr)
+	}
+	// KEY取自Filter，ResetKey不改变结果KEY
+	name := Field("name", AsString(), validator.ResetKey("nickname"))
+	res, _ = Check(context.Background(), map[string]interface{}{"name": "a"}, []validator.Filter{name.Filter()})
+	if v, ok := name.Get(res); !ok || v != "a" {
+		t.Fatal(res)
+	}
+	name = name.WithFilter(NewFilter("nick", []validator.Validator{validator.String()}))
+	res, _ = Check(context.Background(), map[string]interface{}{"nick": "b"}, []validator.Filter{name.Filter()})
+	if v, ok := name.Get(res); name.Key() != "nick" || !ok || v != "b" {
+		t.Fatal(res)
+	}
+}
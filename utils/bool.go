@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// BoolVocab 布尔值词表，字符串不区分大小写并忽略首尾空白
+type BoolVocab struct {
+	words map[string]bool
+	ints  bool
+}
+
+// NewBoolVocab 创建布尔值词表，ints为true时整数1、0分别为true、false
+func NewBoolVocab(trues []string, falses []string, ints bool) *BoolVocab {
+	v := &BoolVocab{
+		words: make(map[string]bool, len(trues)+len(falses)),
+		ints:  ints,
+	}
+	for _, w := range trues {
+		v.words[strings.ToLower(w)] = true
+	}
+	for _, w := range falses {
+		v.words[strings.ToLower(w)] = false
+	}
+	return v
+}
+
+// StdBoolVocab 与strconv.ParseBool一致的词表
+var StdBoolVocab = NewBoolVocab([]string{"1", "t", "true"}, []string{"0", "f", "false"}, false)
+
+// ExtBoolVocab 扩展词表，包含yes/no、on/off（HTML复选框）、是/否及整数1/0
+var ExtBoolVocab = NewBoolVocab(
+	[]string{"1", "t", "true", "y", "yes", "on", "是"},
+	[]string{"0", "f", "false", "n", "no", "off", "否"},
+	true,
+)
+
+// Parse 按词表转为布尔值
+func (v *BoolVocab) Parse(val interface{}) (bool, bool) {
+	if !builtin(val) {
+		if nv, ok := normalize(val, false); ok {
+			return v.Parse(nv)
+		}
+		return false, false
+	}
+	switch b := val.(type) {
+	case bool:
+		return b, true
+	case string:
+		res, ok := v.words[strings.ToLower(strings.TrimSpace(b))]
+		return res, ok
+	case json.Number:
+		return v.Parse(b.String())
+	}
+	if !v.ints {
+		return false, false
+	}
+	i, ok := GetInt64Value(val)
+	if !ok || (i != 0 && i != 1) {
+		return false, false
+	}
+	return i == 1, true
+}
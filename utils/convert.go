@@ -0,0 +1,337 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+var (
+	// ErrUnsupportedType 不支持的类型
+	ErrUnsupportedType = errors.New("unsupported type")
+	// ErrSyntax 格式错误
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrRange 超出目标类型的范围
+	ErrRange = errors.New("value out of range")
+	// ErrFraction 存在小数部分
+	ErrFraction = errors.New("value has fractional part")
+)
+
+// ConvError 类型转换错误，Err为ErrUnsupportedType、ErrSyntax、ErrRange、ErrFraction之一
+type ConvError struct {
+	Value interface{}
+	To    string
+	Err   error
+}
+
+// Error 错误信息
+func (e *ConvError) Error() string {
+	return fmt.Sprintf("utils: cannot convert %T(%v) to %s: %v", e.Value, e.Value, e.To, e.Err)
+}
+
+// Unwrap 转换失败的原因
+func (e *ConvError) Unwrap() error {
+	return e.Err
+}
+
+// convReason 转换失败的原因，不分配内存，供Get系列函数使用
+type convReason int8
+
+const (
+	convOK convReason = iota
+	convType
+	convSyntax
+	convRange
+	convFraction
+)
+
+// err 转为错误
+func (r convReason) err(val interface{}, to string) error {
+	var err error
+	switch r {
+	case convOK:
+		return nil
+	case convSyntax:
+		err = ErrSyntax
+	case convRange:
+		err = ErrRange
+	case convFraction:
+		err = ErrFraction
+	default:
+		err = ErrUnsupportedType
+	}
+	return &ConvError{Value: val, To: to, Err: err}
+}
+
+// numReason strconv错误的原因
+func numReason(err error) convReason {
+	if err == nil {
+		return convOK
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return convRange
+	}
+	return convSyntax
+}
+
+// ToString 转为字符串，数字按完整精度格式化
+func ToString(val interface{}) (string, error) {
+	str, r := toString(val)
+	return str, r.err(val, "string")
+}
+
+// ToInt64 转为int64，规则同GetInt64Value
+func ToInt64(val interface{}) (int64, error) {
+	v, r := toInt64(val)
+	return v, r.err(val, "int64")
+}
+
+// ToUint64 转为uint64，规则同GetUint64Value
+func ToUint64(val interface{}) (uint64, error) {
+	v, r := toUint64(val)
+	return v, r.err(val, "uint64")
+}
+
+// ToFloat64 转为float64，规则同GetFloatValue
+func ToFloat64(val interface{}) (float64, error) {
+	v, r := toFloat64(val)
+	return v, r.err(val, "float64")
+}
+
+// toString 转为字符串
+func toString(val interface{}) (string, convReason) {
+	switch v := val.(type) {
+	case string:
+		return v, convOK
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), convOK
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), convOK
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), convOK
+	case uint64:
+		return strconv.FormatUint(v, 10), convOK
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), convOK
+	case int8:
+		return strconv.FormatInt(int64(v), 10), convOK
+	case int16:
+		return strconv.FormatInt(int64(v), 10), convOK
+	case int32:
+		return strconv.FormatInt(int64(v), 10), convOK
+	case int64:
+		return strconv.FormatInt(v, 10), convOK
+	case int:
+		return strconv.FormatInt(int64(v), 10), convOK
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), convOK
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), convOK
+	case json.Number:
+		return v.String(), convOK
+	case *big.Int:
+		if v == nil {
+			return "", convType
+		}
+		return v.String(), convOK
+	case *big.Float:
+		if v == nil {
+			return "", convType
+		}
+		return v.Text('f', -1), convOK
+	case *big.Rat:
+		if v == nil {
+			return "", convType
+		}
+		return v.RatString(), convOK
+	}
+	if !builtin(val) {
+		if nv, ok := normalize(val, true); ok {
+			return toString(nv)
+		}
+	}
+	return "", convType
+}
+
+// toInt64 转为int64
+func toInt64(val interface{}) (int64, convReason) {
+	switch v := val.(type) {
+	case uint8:
+		return int64(v), convOK
+	case uint16:
+		return int64(v), convOK
+	case uint32:
+		return int64(v), convOK
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, convRange
+		}
+		return int64(v), convOK
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return 0, convRange
+		}
+		return int64(v), convOK
+	case int8:
+		return int64(v), convOK
+	case int16:
+		return int64(v), convOK
+	case int32:
+		return int64(v), convOK
+	case int64:
+		return v, convOK
+	case int:
+		return int64(v), convOK
+	case string:
+		if v == "" {
+			return 0, convOK
+		}
+		v64, err := strconv.ParseInt(v, 10, 64)
+		return v64, numReason(err)
+	case json.Number:
+		v64, err := strconv.ParseInt(v.String(), 10, 64)
+		return v64, numReason(err)
+	case *big.Int:
+		if v == nil {
+			return 0, convType
+		}
+		if !v.IsInt64() {
+			return 0, convRange
+		}
+		return v.Int64(), convOK
+	case *big.Float:
+		if v == nil {
+			return 0, convType
+		}
+		if !v.IsInt() {
+			return 0, convFraction
+		}
+		v64, acc := v.Int64()
+		if acc != big.Exact {
+			return 0, convRange
+		}
+		return v64, convOK
+	}
+	if !builtin(val) {
+		if nv, ok := normalize(val, false); ok {
+			return toInt64(nv)
+		}
+	}
+	return 0, convType
+}
+
+// toUint64 转为uint64
+func toUint64(val interface{}) (uint64, convReason) {
+	switch v := val.(type) {
+	case uint8:
+		return uint64(v), convOK
+	case uint16:
+		return uint64(v), convOK
+	case uint32:
+		return uint64(v), convOK
+	case uint64:
+		return v, convOK
+	case uint:
+		return uint64(v), convOK
+	case string:
+		if v == "" {
+			return 0, convOK
+		}
+		v64, err := strconv.ParseUint(v, 10, 64)
+		return v64, numReason(err)
+	case json.Number:
+		v64, err := strconv.ParseUint(v.String(), 10, 64)
+		return v64, numReason(err)
+	case *big.Int:
+		if v == nil {
+			return 0, convType
+		}
+		if !v.IsUint64() {
+			return 0, convRange
+		}
+		return v.Uint64(), convOK
+	case *big.Float:
+		if v == nil {
+			return 0, convType
+		}
+		if !v.IsInt() {
+			return 0, convFraction
+		}
+		v64, acc := v.Uint64()
+		if acc != big.Exact {
+			return 0, convRange
+		}
+		return v64, convOK
+	}
+	if !builtin(val) {
+		if nv, ok := normalize(val, false); ok {
+			return toUint64(nv)
+		}
+	}
+	v64, r := toInt64(val)
+	if r != convOK {
+		return 0, r
+	}
+	if v64 < 0 {
+		return 0, convRange
+	}
+	return uint64(v64), convOK
+}
+
+// toFloat64 转为float64
+func toFloat64(val interface{}) (float64, convReason) {
+	switch v := val.(type) {
+	case float64:
+		return v, convOK
+	case float32:
+		return float64(v), convOK
+	case string:
+		vf, err := strconv.ParseFloat(v, 64)
+		return vf, numReason(err)
+	case json.Number:
+		vf, err := strconv.ParseFloat(v.String(), 64)
+		return vf, numReason(err)
+	case *big.Int:
+		if v == nil {
+			return 0, convType
+		}
+		vf, _ := new(big.Float).SetInt(v).Float64()
+		if math.IsInf(vf, 0) {
+			return 0, convRange
+		}
+		return vf, convOK
+	case *big.Float:
+		if v == nil {
+			return 0, convType
+		}
+		vf, _ := v.Float64()
+		if math.IsInf(vf, 0) && !v.IsInf() {
+			return 0, convRange
+		}
+		return vf, convOK
+	case *big.Rat:
+		if v == nil {
+			return 0, convType
+		}
+		vf, _ := v.Float64()
+		if math.IsInf(vf, 0) {
+			return 0, convRange
+		}
+		return vf, convOK
+	}
+	if !builtin(val) {
+		if nv, ok := normalize(val, false); ok {
+			return toFloat64(nv)
+		}
+	}
+	if v64, r := toInt64(val); r == convOK {
+		return float64(v64), convOK
+	}
+	if v64, r := toUint64(val); r == convOK {
+		return float64(v64), convOK
+	}
+	return 0, convType
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	strs := []struct {
+		val    interface{}
+		expect string
+	}{
+		{float64(2023100112345678), "2023100112345678"},
+		{0.30000000000000004, "0.30000000000000004"},
+		{float32(0.1), "0.1"},
+		{json.Number("12345678901234567890"), "12345678901234567890"},
+		{new(big.Int).Lsh(big.NewInt(1), 100), "1267650600228229401496703205376"},
+		{big.NewFloat(1.5), "1.5"},
+		{big.NewRat(1, 4), "1/4"},
+	}
+	for _, c := range strs {
+		if str, err := ToString(c.val); err != nil || str != c.expect {
+			t.Fatalf("%v: expect %s, got %s %v", c.val, c.expect, str, err)
+		}
+	}
+
+	errs := []struct {
+		fn     func() error
+		reason error
+	}{
+		{func() error { _, err := ToInt64("12a"); return err }, ErrSyntax},
+		{func() error { _, err := ToInt64("9223372036854775808"); return err }, ErrRange},
+		{func() error { _, err := ToInt64(new(big.Int).Lsh(big.NewInt(1), 64)); return err }, ErrRange},
+		{func() error { _, err := ToInt64(big.NewFloat(1.5)); return err }, ErrFraction},
+		{func() error { _, err := ToUint64(-1); return err }, ErrRange},
+		{func() error { _, err := ToFloat64("1e400"); return err }, ErrRange},
+		{func() error { _, err := ToString([]int{1}); return err }, ErrUnsupportedType},
+	}
+	for idx, c := range errs {
+		err := c.fn()
+		var cerr *ConvError
+		if !errors.As(err, &cerr) || !errors.Is(err, c.reason) {
+			t.Fatalf("case %d: expect %v, got %v", idx, c.reason, err)
+		}
+	}
+	if v, err := ToInt64(big.NewFloat(1 << 40)); err != nil || v != 1<<40 {
+		t.Fatal(v, err)
+	}
+}
+
+type status int
+
+func (s status) String() string {
+	return [...]string{"draft", "active"}[s]
+}
+
+type name string
+
+type flag bool
+
+type price float64
+
+type point struct{ x, y int }
+
+func TestReflect(t *testing.T) {
+	n := 12
+	var nilPtr *int
+	RegisterConverter(point{}, func(val interface{}) (interface{}, error) {
+		p := val.(point)
+		return fmt.Sprintf("%d,%d", p.x, p.y), nil
+	})
+	ints := []struct {
+		val interface{}
+		ok  bool
+		v   int64
+	}{
+		{status(1), true, 1},
+		{name("42"), true, 42},
+		{&n, true, 12},
+		{nilPtr, false, 0},
+		{sql.NullInt64{Int64: 7, Valid: true}, true, 7},
+		{sql.NullInt64{}, false, 0},
+		{&sql.NullInt64{Int64: 8, Valid: true}, true, 8},
+	}
+	for idx, c := range ints {
+		v, ok := GetInt64Value(c.val)
+		if ok != c.ok || v != c.v {
+			t.Fatalf("case %d: expect %d %v, got %d %v", idx, c.v, c.ok, v, ok)
+		}
+	}
+	strs := []struct {
+		val    interface{}
+		expect string
+	}{
+		{status(1), "active"},
+		{name("tom"), "tom"},
+		{sql.NullString{String: "x", Valid: true}, "x"},
+		{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "2024-01-02T03:04:05Z"},
+		{point{1, 2}, "1,2"},
+		{&point{3, 4}, "3,4"},
+	}
+	for idx, c := range strs {
+		if str, ok := GetStringValue(c.val); !ok || str != c.expect {
+			t.Fatalf("case %d: expect %s, got %s", idx, c.expect, str)
+		}
+	}
+	if v, ok := GetBooleanValue(flag(true)); !ok || !v {
+		t.Fatal("flag")
+	}
+	if v, ok := GetFloatValue(price(1.5)); !ok || v != 1.5 {
+		t.Fatal("price")
+	}
+	if _, ok := GetIntStrict(name("42")); ok {
+		t.Fatal("strict named string")
+	}
+	if v, ok := GetIntStrict(status(1)); !ok || v != 1 {
+		t.Fatal("strict named int")
+	}
+	// 严格模式不比宽松模式接受更多的值
+	for _, val := range []interface{}{12.0, float32(12), json.Number("12.0"), json.Number("1.2e1")} {
+		_, i := GetIntStrict(val)
+		_, i64 := GetInt64Strict(val)
+		_, u64 := GetUint64Strict(val)
+		if i || i64 || u64 {
+			t.Fatalf("strict %v(%T)", val, val)
+		}
+	}
+	if v, ok := GetInt64Strict(json.Number("12")); !ok || v != 12 {
+		t.Fatal("strict json.Number")
+	}
+}
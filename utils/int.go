@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// GetInt64Value 转为int64，超出范围时失败
+func GetInt64Value(val interface{}) (int64, bool) {
+	v, r := toInt64(val)
+	return v, r == convOK
+}
+
+// GetUint64Value 转为uint64，负数及超出范围时失败
+func GetUint64Value(val interface{}) (uint64, bool) {
+	v, r := toUint64(val)
+	return v, r == convOK
+}
+
+// GetInt32Value 转为int32，超出范围时失败
+func GetInt32Value(val interface{}) (int32, bool) {
+	v64, ok := GetInt64Value(val)
+	if !ok || v64 < math.MinInt32 || v64 > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(v64), true
+}
+
+// GetUint32Value 转为uint32，负数及超出范围时失败
+func GetUint32Value(val interface{}) (uint32, bool) {
+	v64, ok := GetUint64Value(val)
+	if !ok || v64 > math.MaxUint32 {
+		return 0, false
+	}
+	return uint32(v64), true
+}
+
+// GetInt64Strict 严格转为int64，仅接受整数类型及整数形式的json.Number，不接受字符串及浮点数
+func GetInt64Strict(val interface{}) (int64, bool) {
+	val = strictValue(val)
+	switch val.(type) {
+	case string, float32, float64:
+		return 0, false
+	}
+	return GetInt64Value(val)
+}
+
+// GetUint64Strict 严格转为uint64，仅接受整数类型及整数形式的json.Number，不接受字符串及浮点数
+func GetUint64Strict(val interface{}) (uint64, bool) {
+	val = strictValue(val)
+	switch val.(type) {
+	case string, float32, float64:
+		return 0, false
+	}
+	return GetUint64Value(val)
+}
+
+// GetInt64Slice 获取int64数组，任一元素转换失败或超出范围时失败
+func GetInt64Slice(val interface{}) ([]int64, bool) {
+	switch vSlice := val.(type) {
+	case []int64:
+		return vSlice, true
+	case []int:
+		res := make([]int64, len(vSlice))
+		for k, v := range vSlice {
+			res[k] = int64(v)
+		}
+		return res, true
+	case []string:
+		res := make([]int64, len(vSlice))
+		for k, v := range vSlice {
+			vi, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return []int64{}, false
+			}
+			res[k] = vi
+		}
+		return res, true
+	case []json.Number:
+		res := make([]int64, len(vSlice))
+		for k, v := range vSlice {
+			vi, ok := GetInt64Value(v)
+			if !ok {
+				return []int64{}, false
+			}
+			res[k] = vi
+		}
+		return res, true
+	case []interface{}:
+		res := make([]int64, len(vSlice))
+		for k, v := range vSlice {
+			vi, ok := GetInt64Value(v)
+			if !ok {
+				return []int64{}, false
+			}
+			res[k] = vi
+		}
+		return res, true
+	}
+	return []int64{}, false
+}
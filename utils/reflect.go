@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+)
+
+// Converter 自定义类型的转换函数，将参数值转为内置类型，如int64、float64、string、bool
+type Converter func(val interface{}) (interface{}, error)
+
+var convertersMu sync.RWMutex
+
+// converters 已注册的自定义类型转换函数
+var converters = make(map[reflect.Type]Converter)
+
+// RegisterConverter 注册sample类型的转换函数，已存在时覆盖
+// 应在初始化阶段调用
+func RegisterConverter(sample interface{}, fn Converter) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+	converters[reflect.TypeOf(sample)] = fn
+}
+
+// converter 获取类型的转换函数
+func converter(typ reflect.Type) (Converter, bool) {
+	convertersMu.RLock()
+	defer convertersMu.RUnlock()
+	fn, ok := converters[typ]
+	return fn, ok
+}
+
+// builtin 是否为转换函数直接支持的类型
+func builtin(val interface{}) bool {
+	switch val.(type) {
+	case nil, bool, string, json.Number,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64,
+		*big.Int, *big.Float, *big.Rat:
+		return true
+	}
+	return false
+}
+
+// strictValue 严格模式下将命名类型、指针等转为内置类型，不做字符串与数字的转换
+func strictValue(val interface{}) interface{} {
+	if builtin(val) {
+		return val
+	}
+	if nv, ok := normalize(val, false); ok {
+		return nv
+	}
+	return val
+}
+
+// normalize 基于反射将非内置类型转为内置类型，仅在类型分支未匹配时使用
+// 依次尝试已注册的转换函数、解引用指针、driver.Valuer（如sql.NullInt64）、底层类型；
+// text为true时优先使用encoding.TextMarshaler、fmt.Stringer转为字符串，否则仅在底层类型不支持时使用
+func normalize(val interface{}, text bool) (interface{}, bool) {
+	if val == nil {
+		return nil, false
+	}
+	if fn, ok := converter(reflect.TypeOf(val)); ok {
+		nv, err := fn(val)
+		if err != nil || nv == nil {
+			return nil, false
+		}
+		if builtin(nv) {
+			return nv, true
+		}
+		val = nv
+	}
+	rv := reflect.ValueOf(val)
+	if rv.Kind() == reflect.Ptr {
+		for rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return nil, false
+			}
+			rv = rv.Elem()
+		}
+		val = rv.Interface()
+		if builtin(val) {
+			return val, true
+		}
+		if fn, ok := converter(rv.Type()); ok {
+			nv, err := fn(val)
+			if err != nil || nv == nil {
+				return nil, false
+			}
+			if builtin(nv) {
+				return nv, true
+			}
+			val, rv = nv, reflect.ValueOf(nv)
+		}
+	}
+	if v, ok := val.(driver.Valuer); ok {
+		dv, err := v.Value()
+		if err != nil || dv == nil {
+			return nil, false
+		}
+		if b, ok := dv.([]byte); ok {
+			return string(b), true
+		}
+		if builtin(dv) {
+			return dv, true
+		}
+		val, rv = dv, reflect.ValueOf(dv)
+	}
+	if text {
+		if str, ok := textValue(val); ok {
+			return str, true
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.String:
+		return rv.String(), true
+	}
+	if !text {
+		return textValue(val)
+	}
+	return nil, false
+}
+
+// textValue 通过encoding.TextMarshaler或fmt.Stringer转为字符串
+func textValue(val interface{}) (string, bool) {
+	switch v := val.(type) {
+	case encoding.TextMarshaler:
+		b, err := v.MarshalText()
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	return "", false
+}
@@ -5,6 +5,12 @@ import (
 	"strconv"
 )
 
+// maxInt int的最大值
+const maxInt = int(^uint(0) >> 1)
+
+// minInt int的最小值
+const minInt = -maxInt - 1
+
 // GetIntValFromMap map中读取int值
 func GetIntValFromMap(key string, vals map[string]interface{}) (int, bool) {
 	iv, ok := vals[key]
@@ -23,27 +29,19 @@ func GetStringValFromMap(key string, vals map[string]interface{}) (string, bool)
 	return GetStringValue(sv)
 }
 
-// GetIntValue 转为整数
+// GetIntValue 转为整数，超出int范围时失败
 func GetIntValue(val interface{}) (int, bool) {
 	switch v := val.(type) {
 	case uint8:
 		return int(v), true
 	case uint16:
 		return int(v), true
-	case uint32:
-		return int(v), true
-	case uint64:
-		return int(v), true
-	case uint:
-		return int(v), true
 	case int8:
 		return int(v), true
 	case int16:
 		return int(v), true
 	case int32:
 		return int(v), true
-	case int64:
-		return int(v), true
 	case int:
 		return int(v), true
 	case string:
@@ -55,92 +53,51 @@ func GetIntValue(val interface{}) (int, bool) {
 			return 0, false
 		}
 		return vint, true
-	case json.Number:
-		v64, err := v.Int64()
-		if err != nil {
-			return 0, false
-		}
-		return int(v64), true
 	}
-	return 0, false
+	v64, ok := GetInt64Value(val)
+	if !ok || v64 > int64(maxInt) || v64 < int64(minInt) {
+		return 0, false
+	}
+	return int(v64), true
+}
+
+// GetIntStrict 严格转为整数，仅接受整数类型及整数形式的json.Number，不接受字符串及浮点数
+// 超出int范围时失败
+func GetIntStrict(val interface{}) (int, bool) {
+	val = strictValue(val)
+	switch val.(type) {
+	case string, float32, float64:
+		return 0, false
+	}
+	return GetIntValue(val)
 }
 
 // GetFloatValue 转为浮点
 func GetFloatValue(val interface{}) (float64, bool) {
-	switch v := val.(type) {
-	case uint8:
-		return float64(v), true
-	case uint16:
-		return float64(v), true
-	case uint32:
-		return float64(v), true
-	case uint64:
-		return float64(v), true
-	case uint:
-		return float64(v), true
-	case int8:
-		return float64(v), true
-	case int16:
-		return float64(v), true
-	case int32:
-		return float64(v), true
-	case int64:
-		return float64(v), true
-	case int:
-		return float64(v), true
-	case float32:
-		return float64(v), true
-	case float64:
-		return v, true
-	case string:
-		vf, err := strconv.ParseFloat(v, 64)
-		if err != nil {
-			return 0, false
-		}
-		return vf, true
-	case json.Number:
-		v64, err := v.Float64()
-		if err != nil {
-			return 0, false
-		}
-		return v64, true
+	v, r := toFloat64(val)
+	return v, r == convOK
+}
+
+// GetFloatStrict 严格转为浮点，仅接受数字类型及json.Number，不接受字符串
+func GetFloatStrict(val interface{}) (float64, bool) {
+	val = strictValue(val)
+	if _, ok := val.(string); ok {
+		return 0, false
 	}
-	return 0, false
+	return GetFloatValue(val)
 }
 
-// GetStringValue 获取字符串
+// GetStringValue 获取字符串，数字按完整精度格式化
 func GetStringValue(val interface{}) (string, bool) {
-	switch v := val.(type) {
-	case uint8:
-		return strconv.FormatUint(uint64(v), 10), true
-	case uint16:
-		return strconv.FormatUint(uint64(v), 10), true
-	case uint32:
-		return strconv.FormatUint(uint64(v), 10), true
-	case uint64:
-		return strconv.FormatUint(uint64(v), 10), true
-	case uint:
-		return strconv.FormatUint(uint64(v), 10), true
-	case int8:
-		return strconv.FormatInt(int64(v), 10), true
-	case int16:
-		return strconv.FormatInt(int64(v), 10), true
-	case int32:
-		return strconv.FormatInt(int64(v), 10), true
-	case int64:
-		return strconv.FormatInt(v, 10), true
-	case int:
-		return strconv.FormatInt(int64(v), 10), true
-	case float32:
-		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
-	case float64:
-		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
-	case string:
-		return v, true
-	case json.Number:
-		return v.String(), true
-	}
-	return "", false
+	str, r := toString(val)
+	return str, r == convOK
+}
+
+// GetStringStrict 严格获取字符串，仅接受字符串类型
+func GetStringStrict(val interface{}) (string, bool) {
+	val = strictValue(val)
+	str, ok := val.(string)
+	return str, ok
 }
 
 // GetBooleanValue 获取布尔值
@@ -161,9 +118,21 @@ func GetBooleanValue(val interface{}) (bool, bool) {
 		}
 		return vbool, true
 	}
+	if !builtin(val) {
+		if nv, ok := normalize(val, false); ok {
+			return GetBooleanValue(nv)
+		}
+	}
 	return false, false
 }
 
+// GetBooleanStrict 严格获取布尔值，仅接受布尔类型
+func GetBooleanStrict(val interface{}) (bool, bool) {
+	val = strictValue(val)
+	b, ok := val.(bool)
+	return b, ok
+}
+
 // GetIntSlice 获取整形数组
 func GetIntSlice(val interface{}) ([]int, bool) {
 	switch vSlice := val.(type) {
@@ -203,6 +172,51 @@ func GetIntSlice(val interface{}) ([]int, bool) {
 	return []int{}, false
 }
 
+// GetFloatSlice 获取浮点数组
+func GetFloatSlice(val interface{}) ([]float64, bool) {
+	switch vSlice := val.(type) {
+	case []float64:
+		return vSlice, true
+	case []int:
+		res := make([]float64, len(vSlice))
+		for k, v := range vSlice {
+			res[k] = float64(v)
+		}
+		return res, true
+	case []string:
+		res := make([]float64, len(vSlice))
+		for k, v := range vSlice {
+			vf, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return []float64{}, false
+			}
+			res[k] = vf
+		}
+		return res, true
+	case []json.Number:
+		res := make([]float64, len(vSlice))
+		for k, v := range vSlice {
+			vf, err := v.Float64()
+			if err != nil {
+				return []float64{}, false
+			}
+			res[k] = vf
+		}
+		return res, true
+	case []interface{}:
+		res := make([]float64, len(vSlice))
+		for k, v := range vSlice {
+			vf, ok := GetFloatValue(v)
+			if !ok {
+				return []float64{}, false
+			}
+			res[k] = vf
+		}
+		return res, true
+	}
+	return []float64{}, false
+}
+
 // GetStringSlice 获取字符串数组
 func GetStringSlice(val interface{}) ([]string, bool) {
 	switch vSlice := val.(type) {
@@ -2,29 +2,27 @@ package govalidate
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"strconv"
 
 	"github.com/rumis/govalidate/validator"
 )
 
 // NewFilter 构建新的FilterItem对象
+// 错误信息模式跟随校验引擎的配置
 func NewFilter(key string, rules []validator.Validator, errMsgCode ...string) validator.Filter {
-	var err string
-	if len(errMsgCode) > 0 {
-		err = errMsgCode[0]
-	}
-	var code int32
-	if len(errMsgCode) > 1 {
-		eCode, _ := strconv.Atoi(errMsgCode[1])
-		code = int32(eCode)
-	}
+	err, code := parseErrMsgCode(errMsgCode)
 	return validator.NewNormalFilter(key, rules, err, code)
 }
 
 // NewMultiLangFilter 多语言Filter对象
+// Filter及规则的错误信息均作为消息ID，由context中的Localizer翻译
 func NewMultiLangFilter(key string, rules []validator.Validator, errMsgCode ...string) validator.Filter {
+	err, code := parseErrMsgCode(errMsgCode)
+	return validator.NewMultiLangFilter(key, rules, err, code)
+}
+
+// parseErrMsgCode 解析错误信息及错误码
+func parseErrMsgCode(errMsgCode []string) (string, int32) {
 	var err string
 	if len(errMsgCode) > 0 {
 		err = errMsgCode[0]
@@ -34,57 +32,57 @@ func NewMultiLangFilter(key string, rules []validator.Validator, errMsgCode ...s
 		eCode, _ := strconv.Atoi(errMsgCode[1])
 		code = int32(eCode)
 	}
-	return validator.NewMultiLangFilter(key, rules, err, code)
+	return err, code
+}
+
+// WithLabel 设置Filter的显示名称，显示名称作为消息ID由context中的Localizer翻译后替换错误信息中的{field}
+// 仅支持validator.NormalFilter及validator.MultiLangFilter，其他Filter原样返回
+func WithLabel(filter validator.Filter, label string) validator.Filter {
+	switch f := filter.(type) {
+	case validator.NormalFilter:
+		return f.WithLabel(label)
+	case validator.MultiLangFilter:
+		return f.WithLabel(label)
+	}
+	return filter
+}
+
+// WithTypeMode 设置Filter的类型模式，优先于校验引擎的配置
+// 仅支持validator.NormalFilter及validator.MultiLangFilter，其他Filter原样返回
+func WithTypeMode(filter validator.Filter, mode validator.TypeMode) validator.Filter {
+	switch f := filter.(type) {
+	case validator.NormalFilter:
+		return f.WithTypeMode(mode)
+	case validator.MultiLangFilter:
+		return f.WithTypeMode(mode)
+	}
+	return filter
+}
+
+// NewUnionFilter 按类型字段的值选择规则集，校验全部参数
+// variants中KEY为validator.UnionDefault的规则集用于未知类型，不存在时未知类型校验失败
+func NewUnionFilter(typeKey string, variants map[string][]validator.Filter, errMsgCode ...string) validator.Filter {
+	return NewFilter(typeKey, []validator.Validator{validator.UnionParams(typeKey, variants)}, errMsgCode...)
 }
 
 // Validate 校验
 func Validate(params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, int32, error) {
-	ctx := context.Background()
-	return Validate1(ctx, params, rules)
+	return defaultEngine.Validate(params, rules)
 }
 
 // Validate1 校验
+// 校验失败时返回的error为*validator.FieldError
 func Validate1(ctx context.Context, params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, int32, error) {
-	if len(rules) == 0 {
-		return nil, 0, nil
-	}
-	vRes := make(map[string]interface{})
-	for _, filter := range rules {
-		key := filter.Key(ctx)
-		paramVal, ok := params[key]
-		if !ok {
-			paramVal = nil
-		}
-		opts := &validator.ValidateOptions{
-			Key:    key,
-			Value:  paramVal,
-			Params: params,
-		}
-		for _, fn := range filter.Rules(ctx) {
-			res := fn(opts)
-			if res.Stat(ctx) == validator.VS_BREAK {
-				break
-			}
-			if res.Stat(ctx) == validator.VS_FAILUE {
-				if res.ErrMsg(ctx) != "" {
-					return vRes, filter.ErrCode(ctx), errors.New(res.ErrMsg(ctx))
-				}
-				if filter.ErrMsg(ctx) != "" {
-					return vRes, filter.ErrCode(ctx), errors.New(filter.ErrMsg(ctx))
-				}
-				return vRes, filter.ErrCode(ctx), fmt.Errorf("field %s error", key)
-			}
-		}
-		// 记录校验结果
-		if opts.Value != nil && opts.Key != "-" {
-			vRes[opts.Key] = opts.Value
-		}
-		// 记录扩展数据
-		if opts.Extend != nil {
-			for ek, ev := range opts.Extend {
-				vRes[ek] = ev
-			}
-		}
-	}
-	return vRes, 1, nil
+	return defaultEngine.Validate1(ctx, params, rules)
+}
+
+// Check 校验，遇到首个错误时中断，校验通过时错误为nil
+func Check(ctx context.Context, params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, *validator.FieldError) {
+	return defaultEngine.Check(ctx, params, rules)
+}
+
+// ValidateAll 校验全部参数，不在首个错误处中断
+// 返回所有校验失败字段的错误，全部通过时错误列表为空
+func ValidateAll(ctx context.Context, params map[string]interface{}, rules []validator.Filter) (map[string]interface{}, []*validator.FieldError) {
+	return defaultEngine.ValidateAll(ctx, params, rules)
 }
@@ -422,3 +422,120 @@ func BenchmarkValidate(b *testing.B) {
 		_, _, _ = Validate(params, rules)
 	}
 }
+
+func TestUnion(t *testing.T) {
+	variants := map[string][]validator.Filter{
+		"card": {
+			NewFilter("card_no", []validator.Validator{validator.Required(), validator.Length(16, 19)}, "卡号错误", "20001"),
+		},
+		"alipay": {
+			NewFilter("account", []validator.Validator{validator.Required(), validator.Email()}),
+		},
+	}
+
+	// 整个参数对象
+	rules := []validator.Filter{
+		NewFilter("id", []validator.Validator{validator.Required(), validator.Int()}),
+		NewUnionFilter("type", variants, "未知类型", "20000"),
+	}
+	res, _, err := Validate(map[string]interface{}{"id": 1, "type": "alipay", "account": "a@b.com"}, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res["type"] != "alipay" || res["account"] != "a@b.com" {
+		t.Fatal(res)
+	}
+	_, code, err := Validate(map[string]interface{}{"id": 1, "type": "cash"}, rules)
+	if err == nil || err.Error() != "未知类型" || code != 20000 {
+		t.Fatal(err, code)
+	}
+
+	// 嵌套参数
+	rules = []validator.Filter{
+		NewFilter("payment", []validator.Validator{validator.Required(), validator.Union("type", variants)}, "", "20000"),
+	}
+	_, code, err = Validate(map[string]interface{}{
+		"payment": map[string]interface{}{"type": "card", "card_no": "123"},
+	}, rules)
+	ferr, ok := err.(*validator.FieldError)
+	if !ok || ferr.Field != "payment.card_no" || ferr.Msg != "卡号错误" || code != 20001 {
+		t.Fatal(err, code)
+	}
+
+	// 默认类型
+	variants[validator.UnionDefault] = []validator.Filter{
+		NewFilter("memo", []validator.Validator{validator.Optional("")}),
+	}
+	res, _, err = Validate(map[string]interface{}{
+		"payment": map[string]interface{}{"type": "cash"},
+	}, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := res["payment"].(map[string]interface{}); !ok || p["type"] != "cash" || p[validator.UnionVariantKey] != validator.UnionDefault || p["memo"] != "" {
+		t.Fatal(res)
+	}
+	res, _, err = Validate(map[string]interface{}{"id": 1}, []validator.Filter{NewUnionFilter("type", variants)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := res["type"]; ok || res[validator.UnionVariantKey] != validator.UnionDefault || res["memo"] != "" {
+		t.Fatal(res)
+	}
+	// 匹配的类型不记录UnionVariantKey
+	res, _, err = Validate(map[string]interface{}{"id": 1, "type": "alipay", "account": "a@b.com"}, []validator.Filter{NewUnionFilter("type", variants)})
+	if _, ok := res[validator.UnionVariantKey]; err != nil || ok || res["type"] != "alipay" {
+		t.Fatal(res, err)
+	}
+}
+
+func TestSchemas(t *testing.T) {
+	schemas := validator.NewSchemas(3)
+	schemas.Define("comment", []validator.Filter{
+		NewFilter("text", []validator.Validator{validator.Required(), validator.Length(1, 10)}, "内容错误"),
+		NewFilter("children", []validator.Validator{validator.Optional(), validator.Each([]validator.Validator{schemas.Ref("comment")})}),
+	})
+	schemas.Define("address", []validator.Filter{
+		NewFilter("city", []validator.Validator{validator.Required(), validator.String()}),
+	})
+	rules := []validator.Filter{
+		NewFilter("billing", []validator.Validator{validator.Required(), schemas.Ref("address")}),
+		NewFilter("shipping", []validator.Validator{validator.Optional(), schemas.Ref("address")}),
+		NewFilter("comment", []validator.Validator{validator.Required(), schemas.Ref("comment")}),
+	}
+	leaf := func(text string) map[string]interface{} {
+		return map[string]interface{}{"text": text}
+	}
+	params := map[string]interface{}{
+		"billing": map[string]interface{}{"city": "北京"},
+		"comment": map[string]interface{}{
+			"text":     "root",
+			"children": []interface{}{leaf("a"), map[string]interface{}{"text": "b", "children": []interface{}{leaf("c")}}},
+		},
+	}
+	res, _, err := Validate(params, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	children := res["comment"].(map[string]interface{})["children"].([]interface{})
+	if len(children) != 2 {
+		t.Fatal(res)
+	}
+
+	params["comment"].(map[string]interface{})["children"] = []interface{}{leaf("a"), leaf("")}
+	_, _, err = Validate(params, rules)
+	if ferr, ok := err.(*validator.FieldError); !ok || ferr.Field != "comment.children.1.text" || ferr.Msg != "内容错误" {
+		t.Fatal(err)
+	}
+
+	// 超过最大深度
+	deep := leaf("d")
+	for i := 0; i < 3; i++ {
+		deep = map[string]interface{}{"text": "d", "children": []interface{}{deep}}
+	}
+	params["comment"] = deep
+	_, _, err = Validate(params, rules)
+	if ferr, ok := err.(*validator.FieldError); !ok || ferr.Field != "comment.children.0.children.0.children.0" {
+		t.Fatal(err)
+	}
+}
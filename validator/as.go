@@ -0,0 +1,105 @@
+package validator
+
+import (
+	"encoding"
+	"reflect"
+	"sync"
+)
+
+// ParseFunc 将字符串解析为自定义类型
+type ParseFunc func(str string) (interface{}, error)
+
+var parsersMu sync.RWMutex
+
+// parsers 已注册的自定义类型解析函数
+var parsers = make(map[reflect.Type]ParseFunc)
+
+// RegisterParser 注册sample类型的解析函数，供As使用，优先于encoding.TextUnmarshaler
+// 应在初始化阶段调用
+func RegisterParser(sample interface{}, fn ParseFunc) {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+	parsers[reflect.TypeOf(sample)] = fn
+}
+
+// parser 获取类型的解析函数
+func parser(typ reflect.Type) (ParseFunc, bool) {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+	fn, ok := parsers[typ]
+	return fn, ok
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// As 将字符串参数解析为target的类型，参数值替换为解析结果
+// target为类型的零值或指针，如 As(net.IP{})、As(new(OrderStatus))，为指针时参数值也为指针
+// 依次使用RegisterParser注册的解析函数、encoding.TextUnmarshaler，参数值已是目标类型时直接通过
+func As(target interface{}, emsg ...string) Validator {
+	typ := reflect.TypeOf(target)
+	var base reflect.Type
+	if typ != nil {
+		base = typ
+		if typ.Kind() == reflect.Ptr {
+			base = typ.Elem()
+		}
+	}
+	return func(opts *ValidateOptions) ValidateResult {
+		if typ == nil {
+			return FailMsg(emsg, "format", map[string]interface{}{"type": "<nil>"})
+		}
+		if opts.Value != nil && reflect.TypeOf(opts.Value) == typ {
+			return Succ()
+		}
+		str, ok := stringValue(opts)
+		if !ok {
+			return FailMsg(emsg, "format", map[string]interface{}{"type": typ.String()})
+		}
+		val, ok := parseAs(str, typ, base)
+		if !ok {
+			return FailMsg(emsg, "format", map[string]interface{}{"type": typ.String()})
+		}
+		opts.Value = val
+		return Succ()
+	}
+}
+
+// parseAs 将字符串解析为typ类型，base为typ本身或其指向的类型
+func parseAs(str string, typ reflect.Type, base reflect.Type) (interface{}, bool) {
+	for _, t := range []reflect.Type{typ, base} {
+		fn, ok := parser(t)
+		if !ok {
+			continue
+		}
+		val, err := fn(str)
+		if err != nil || val == nil {
+			return nil, false
+		}
+		rv := reflect.ValueOf(val)
+		switch {
+		case rv.Type() == typ:
+			return val, true
+		case rv.Type() == base:
+			ptr := reflect.New(base)
+			ptr.Elem().Set(rv)
+			return ptr.Interface(), true
+		case rv.Kind() == reflect.Ptr && rv.Type().Elem() == typ:
+			if rv.IsNil() {
+				return nil, false
+			}
+			return rv.Elem().Interface(), true
+		}
+		return nil, false
+	}
+	ptr := reflect.New(base)
+	if !ptr.Type().Implements(textUnmarshalerType) {
+		return nil, false
+	}
+	if err := ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str)); err != nil {
+		return nil, false
+	}
+	if typ == base {
+		return ptr.Elem().Interface(), true
+	}
+	return ptr.Interface(), true
+}
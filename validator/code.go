@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"sync"
+)
+
+const (
+	// CODE_NONE 未设置错误码
+	CODE_NONE int32 = 0
+	// CODE_SUCCESS 校验通过，仅用于兼容Validate1的返回值，错误码不应使用该值
+	CODE_SUCCESS int32 = 1
+)
+
+var codesMu sync.RWMutex
+
+// codes 已注册的错误码及其描述
+var codes = make(map[int32]string)
+
+// RegisterCode 注册错误码及其描述，已存在的错误码会被覆盖
+// 应在初始化阶段调用
+func RegisterCode(code int32, desc string) {
+	codesMu.Lock()
+	defer codesMu.Unlock()
+	codes[code] = desc
+}
+
+// CodeDesc 错误码的描述
+func CodeDesc(code int32) (string, bool) {
+	codesMu.RLock()
+	defer codesMu.RUnlock()
+	desc, ok := codes[code]
+	return desc, ok
+}
+
+// Codes 已注册的全部错误码
+func Codes() map[int32]string {
+	codesMu.RLock()
+	defer codesMu.RUnlock()
+	res := make(map[int32]string, len(codes))
+	for code, desc := range codes {
+		res[code] = desc
+	}
+	return res
+}
+
+// WithCode 设置规则校验失败时的错误码，优先于Filter的错误码
+func WithCode(code int32, rule Validator) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		return resultWithCode(rule(opts), code)
+	}
+}
+
+// resultWithCode 为校验失败结果设置错误码，已设置错误码的结果不变
+func resultWithCode(res ValidateResult, code int32) ValidateResult {
+	switch r := res.(type) {
+	case NormalValidateResult:
+		if r.Status == VS_FAILUE && r.Code == 0 {
+			r.Code = code
+		}
+		return r
+	case MultiLangValidateResult:
+		if r.Status == VS_FAILUE && r.Code == 0 {
+			r.Code = code
+		}
+		return r
+	case nestedResult:
+		if r.err != nil && r.err.Code == 0 {
+			ferr := *r.err
+			ferr.Code = code
+			r.err = &ferr
+		}
+		if r.res != nil {
+			r.res = resultWithCode(r.res, code)
+		}
+		return r
+	}
+	return res
+}
@@ -0,0 +1,61 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rumis/govalidate/utils"
+)
+
+// configKey 校验配置的context key
+type configKey struct{}
+
+// Config 校验配置，通过context传递给Filter及规则
+type Config struct {
+	// LocalizerKey 从context中获取Localizer的key，为nil时使用GetLocalizerKey()
+	LocalizerKey interface{}
+	// Localizer 获取Localizer，设置后不再按LocalizerKey查找
+	Localizer func(ctx context.Context) Localizer
+	// MessageMode 错误信息模式，Filter未指定时使用
+	MessageMode MessageMode
+	// Lang 内置默认错误信息的语言，为空时使用DefaultLang
+	Lang string
+	// DefaultMsg 规则和Filter均未设置错误信息时使用，%s为参数路径，设置后不再使用内置默认错误信息
+	DefaultMsg string
+	// MaxDepth 命名规则集引用深度的上限，与Schemas的设置取较小值，为0时使用Schemas的设置
+	MaxDepth int
+	// TypeMode 类型模式，Filter未指定时使用
+	TypeMode TypeMode
+	// BoolVocab 宽松模式下Boolean规则使用的布尔值词表，为nil时与strconv.ParseBool一致
+	BoolVocab *utils.BoolVocab
+	// Location Time规则解析不含时区信息的时间时使用的时区，为nil时使用DefaultLocation
+	Location *time.Location
+	// Now 相对时间规则使用的当前时间函数，为nil时使用time.Now
+	Now func() time.Time
+}
+
+// WithConfig 设置校验配置
+func WithConfig(ctx context.Context, cfg *Config) context.Context {
+	return context.WithValue(ctx, configKey{}, cfg)
+}
+
+// ConfigFrom 获取校验配置，未设置时返回nil
+func ConfigFrom(ctx context.Context) *Config {
+	cfg, _ := ctx.Value(configKey{}).(*Config)
+	return cfg
+}
+
+// defaultErrMsg 默认错误信息
+// 依次取校验配置的DefaultMsg、消息KEY对应的默认错误信息、field %s error
+func defaultErrMsg(ctx context.Context, field string, key string, args map[string]interface{}) string {
+	if cfg := ConfigFrom(ctx); cfg != nil && cfg.DefaultMsg != "" {
+		return fmt.Sprintf(cfg.DefaultMsg, field)
+	}
+	if key != "" {
+		if msg := Message(ctx, key, args); msg != "" {
+			return msg
+		}
+	}
+	return fmt.Sprintf("field %s error", field)
+}
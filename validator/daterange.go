@@ -0,0 +1,81 @@
+package validator
+
+import "time"
+
+// DateRangeOptions DateRange规则的配置
+type DateRangeOptions struct {
+	// Start 开始时间的参数KEY，为空时为start_date
+	Start string
+	// End 结束时间的参数KEY，为空时为end_date
+	End string
+	// Layouts 依次尝试的时间格式，为空时使用DefaultTimeLayouts
+	Layouts []string
+	// Location 解析不含时区信息的时间时使用的时区，为nil时依次使用校验配置的Location、DefaultLocation
+	Location *time.Location
+	// MinSpan 起止时间的最小间隔，为0时不限制
+	MinSpan time.Duration
+	// MaxSpan 起止时间的最大间隔，为0时不限制
+	MaxSpan time.Duration
+	// Inclusive 按天的闭区间，间隔为起止日期相差的天数加1，如 2024-01-01 至 2024-01-31 为31天
+	// 按各自时区的年月日计算，不受夏令时切换影响
+	Inclusive bool
+}
+
+// DateRange 起止时间，开始时间不能晚于结束时间，间隔须在[MinSpan,MaxSpan]之间
+// 起止时间均转为time.Time写入Extend，可用于任意KEY的Filter，如
+//
+//	NewFilter("start_date", []Validator{Required(), DateRange(DateRangeOptions{MaxSpan: 31 * 24 * time.Hour, Inclusive: true})})
+func DateRange(o DateRangeOptions, emsg ...string) Validator {
+	if o.Start == "" {
+		o.Start = "start_date"
+	}
+	if o.End == "" {
+		o.End = "end_date"
+	}
+	layouts := o.Layouts
+	if len(layouts) == 0 {
+		layouts = DefaultTimeLayouts
+	}
+	args := map[string]interface{}{"start": o.Start, "end": o.End}
+	spanArgs := func(span time.Duration) map[string]interface{} {
+		return map[string]interface{}{"start": o.Start, "end": o.End, "span": formatDuration(span)}
+	}
+	return func(opts *ValidateOptions) ValidateResult {
+		sub := *opts
+		sub.Value = opts.Params[o.Start]
+		start, _, ok := parseTime(&sub, layouts, o.Location)
+		if !ok {
+			return FailMsg(emsg, "date_range_time", args)
+		}
+		sub.Value = opts.Params[o.End]
+		end, _, ok := parseTime(&sub, layouts, o.Location)
+		if !ok {
+			return FailMsg(emsg, "date_range_time", args)
+		}
+		if start.After(end) {
+			return FailMsg(emsg, "date_range", args)
+		}
+		span := end.Sub(start)
+		if o.Inclusive {
+			span = time.Duration(days(end)-days(start)+1) * 24 * time.Hour
+		}
+		if o.MinSpan > 0 && span < o.MinSpan {
+			return FailMsg(emsg, "date_range_min", spanArgs(o.MinSpan))
+		}
+		if o.MaxSpan > 0 && span > o.MaxSpan {
+			return FailMsg(emsg, "date_range_max", spanArgs(o.MaxSpan))
+		}
+		if opts.Extend == nil {
+			opts.Extend = make(map[string]interface{}, 2)
+		}
+		opts.Extend[o.Start] = start
+		opts.Extend[o.End] = end
+		return Succ()
+	}
+}
+
+// days 时间所在日期距1970-01-01的天数，按时间自身时区的年月日计算
+func days(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+}
@@ -0,0 +1,69 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestDateRange(t *testing.T) {
+	day := 24 * time.Hour
+	month := DateRange(DateRangeOptions{MaxSpan: 31 * day, Inclusive: true, Location: time.UTC})
+	week := DateRange(DateRangeOptions{Start: "from", End: "to", MinSpan: day, MaxSpan: 7 * day})
+	cases := []struct {
+		rule   Validator
+		params map[string]interface{}
+		key    string
+	}{
+		{month, map[string]interface{}{"start_date": "2024-01-01", "end_date": "2024-01-31"}, ""},
+		{month, map[string]interface{}{"start_date": "2024-01-01", "end_date": "2024-02-01"}, "date_range_max"},
+		{month, map[string]interface{}{"start_date": "2024-01-31", "end_date": "2024-01-31"}, ""},
+		{month, map[string]interface{}{"start_date": "2024-02-01", "end_date": "2024-01-31"}, "date_range"},
+		{month, map[string]interface{}{"start_date": "2024-01-01"}, "date_range_time"},
+		{month, map[string]interface{}{"start_date": "2024-01-01", "end_date": "2024-13-01"}, "date_range_time"},
+		{week, map[string]interface{}{"from": "2024-01-01 08:00:00", "to": "2024-01-02 08:00:00"}, ""},
+		{week, map[string]interface{}{"from": "2024-01-01 08:00:00", "to": "2024-01-02 07:59:59"}, "date_range_min"},
+		{week, map[string]interface{}{"from": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), "to": "2024-01-08T00:00:00Z"}, ""},
+	}
+	for idx, c := range cases {
+		opts := &ValidateOptions{Key: "range", Params: c.params}
+		res := c.rule(opts)
+		if c.key == "" {
+			if res.Stat(context.Background()) != VS_SUCCESS || len(opts.Extend) != 2 {
+				t.Fatalf("case %d: %v", idx, res)
+			}
+			continue
+		}
+		if key, _ := resultMsgKey(res); res.Stat(context.Background()) != VS_FAILUE || key != c.key {
+			t.Fatalf("case %d: %v", idx, res)
+		}
+	}
+
+	opts := &ValidateOptions{Params: map[string]interface{}{"start_date": "2024-01-01", "end_date": "2024-01-31"}}
+	month(opts)
+	if !opts.Extend["end_date"].(time.Time).Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal(opts.Extend)
+	}
+	if msg := Message(WithConfig(context.Background(), &Config{Lang: "en"}), "date_range_max", map[string]interface{}{"start": "start_date", "end": "end_date", "span": "31d"}); msg != "the range from start_date to end_date must not exceed 31d" {
+		t.Fatal(msg)
+	}
+
+	// 夏令时切换不影响天数，2024-11-03纽约结束夏令时，当天为25小时
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nov := DateRange(DateRangeOptions{MaxSpan: 30 * day, Inclusive: true, Location: ny})
+	for end, ok := range map[string]bool{"2024-11-30": true, "2024-12-01": false} {
+		opts := &ValidateOptions{Params: map[string]interface{}{"start_date": "2024-11-01", "end_date": end}}
+		if res := nov(opts); (res.Stat(context.Background()) == VS_SUCCESS) != ok {
+			t.Fatal(end, res)
+		}
+	}
+	spring := DateRange(DateRangeOptions{MinSpan: 10 * day, Inclusive: true, Location: ny})
+	opts = &ValidateOptions{Params: map[string]interface{}{"start_date": "2024-03-01", "end_date": "2024-03-10"}}
+	if res := spring(opts); res.Stat(context.Background()) != VS_SUCCESS {
+		t.Fatal(res)
+	}
+}
@@ -0,0 +1,256 @@
+package validator
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DecimalOutput 精确小数的输出格式
+type DecimalOutput int8
+
+const (
+	// DO_STRING 规范化的小数字符串，如 19.90，设置了小数位数时补齐尾部的0，否则去除
+	DO_STRING DecimalOutput = 0
+	// DO_MINOR_UNITS 以最小货币单位表示的int64，如 19.90 元为 1990 分，需设置Currency或Scale
+	DO_MINOR_UNITS DecimalOutput = 1
+	// DO_RAT *big.Rat
+	DO_RAT DecimalOutput = 2
+)
+
+// DecimalOptions 精确小数规则的配置
+type DecimalOptions struct {
+	// Precision 总位数上限，设置Scale时整数部分不超过Precision-Scale位，为0时不限制
+	Precision int
+	// Scale 小数位数上限，为0时使用Currency的小数位数，均未设置时不限制
+	Scale int
+	// Min Max 取值范围，十进制字符串，为空时不限制
+	Min string
+	Max string
+	// Currency ISO 4217 货币代码，如 CNY、JPY
+	Currency string
+	// Output 输出格式
+	Output DecimalOutput
+}
+
+// decimal 精确小数，值为 unscaled * 10^-scale
+type decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// maxDecimalExp 科学计数法指数的绝对值上限
+const maxDecimalExp = 1000
+
+// Decimal 精确小数，参数为字符串、json.Number或整数，不经过float64转换
+// 宽松模式下接受float64，按其最短十进制表示解析；严格模式下浮点数校验失败
+// 配置错误（未知的Currency、无法解析的Min或Max、DO_MINOR_UNITS未设置Scale及Currency）时校验失败，错误信息为decimal_config，不使用emsg
+func Decimal(o DecimalOptions, emsg ...string) Validator {
+	scale := o.Scale
+	cfgErr := ""
+	if o.Currency != "" {
+		units, ok := CurrencyMinorUnits(o.Currency)
+		if !ok {
+			cfgErr = "unknown currency " + o.Currency
+		}
+		if scale == 0 {
+			scale = units
+		}
+	}
+	var min, max *big.Rat
+	if o.Min != "" {
+		d, ok := parseDecimal(o.Min)
+		if !ok && cfgErr == "" {
+			cfgErr = "invalid min " + o.Min
+		}
+		min = d.rat()
+	}
+	if o.Max != "" {
+		d, ok := parseDecimal(o.Max)
+		if !ok && cfgErr == "" {
+			cfgErr = "invalid max " + o.Max
+		}
+		max = d.rat()
+	}
+	if o.Output == DO_MINOR_UNITS && scale == 0 && o.Currency == "" && cfgErr == "" {
+		cfgErr = "minor units output requires Scale or Currency"
+	}
+	return func(opts *ValidateOptions) ValidateResult {
+		if cfgErr != "" {
+			return FailMsg(nil, "decimal_config", map[string]interface{}{"reason": cfgErr})
+		}
+		d, ok := decimalValue(opts)
+		if !ok {
+			return FailMsg(emsg, "decimal", nil)
+		}
+		if scale > 0 || o.Currency != "" {
+			if d.scale > scale {
+				return FailMsg(emsg, "decimal_scale", map[string]interface{}{"scale": scale})
+			}
+		}
+		if o.Precision > 0 {
+			digits := d.intDigits()
+			if scale > 0 {
+				digits += scale
+			} else {
+				digits += d.scale
+			}
+			if digits > o.Precision {
+				return FailMsg(emsg, "decimal_precision", map[string]interface{}{"precision": o.Precision, "scale": scale})
+			}
+		}
+		if min != nil || max != nil {
+			r := d.rat()
+			below, above := min != nil && r.Cmp(min) < 0, max != nil && r.Cmp(max) > 0
+			switch {
+			case (below || above) && min != nil && max != nil:
+				return FailMsg(emsg, "between", map[string]interface{}{"min": o.Min, "max": o.Max})
+			case below:
+				return FailMsg(emsg, "min", map[string]interface{}{"min": o.Min})
+			case above:
+				return FailMsg(emsg, "max", map[string]interface{}{"max": o.Max})
+			}
+		}
+		switch o.Output {
+		case DO_MINOR_UNITS:
+			v := new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+			if !v.IsInt64() {
+				return FailMsg(emsg, "decimal", nil)
+			}
+			opts.Value = v.Int64()
+		case DO_RAT:
+			opts.Value = d.rat()
+		default:
+			opts.Value = d.format(scale)
+		}
+		return Succ()
+	}
+}
+
+// Money 金额，小数位数为货币的最小单位，参数值转为以最小货币单位表示的int64，如 19.99 元为 1999 分
+func Money(currency string, emsg ...string) Validator {
+	return Decimal(DecimalOptions{Currency: currency, Output: DO_MINOR_UNITS}, emsg...)
+}
+
+// decimalValue 参数值转为精确小数
+func decimalValue(opts *ValidateOptions) (decimal, bool) {
+	switch v := opts.Value.(type) {
+	case string:
+		return parseDecimal(v)
+	case json.Number:
+		return parseDecimal(v.String())
+	case float32, float64:
+		if opts.Strict() {
+			return decimal{}, false
+		}
+		f, _ := floatValue(opts)
+		return parseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	}
+	v, ok := int64Value(opts)
+	if !ok {
+		if u, ok := uint64Value(opts); ok {
+			return decimal{unscaled: new(big.Int).SetUint64(u)}, true
+		}
+		return decimal{}, false
+	}
+	return decimal{unscaled: big.NewInt(v)}, true
+}
+
+// parseDecimal 解析十进制小数，支持科学计数法，如 -19.90、1.5e3
+func parseDecimal(s string) (decimal, bool) {
+	mant, exp := s, 0
+	if idx := strings.IndexAny(s, "eE"); idx >= 0 {
+		e, err := strconv.Atoi(s[idx+1:])
+		if err != nil || e > maxDecimalExp || e < -maxDecimalExp {
+			return decimal{}, false
+		}
+		mant, exp = s[:idx], e
+	}
+	neg := false
+	if mant != "" && (mant[0] == '+' || mant[0] == '-') {
+		neg = mant[0] == '-'
+		mant = mant[1:]
+	}
+	intPart, fracPart := mant, ""
+	if idx := strings.IndexByte(mant, '.'); idx >= 0 {
+		intPart, fracPart = mant[:idx], mant[idx+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return decimal{}, false
+	}
+	digits := intPart + fracPart
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return decimal{}, false
+		}
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return decimal{}, false
+	}
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	d := decimal{unscaled: unscaled, scale: len(fracPart) - exp}
+	if d.scale < 0 {
+		d.unscaled.Mul(d.unscaled, pow10(-d.scale))
+		d.scale = 0
+	}
+	d.trim()
+	return d, true
+}
+
+// trim 去除小数部分尾部的0
+func (d *decimal) trim() {
+	ten := big.NewInt(10)
+	q, r := new(big.Int), new(big.Int)
+	for d.scale > 0 {
+		q.QuoRem(d.unscaled, ten, r)
+		if r.Sign() != 0 {
+			break
+		}
+		d.unscaled.Set(q)
+		d.scale--
+	}
+}
+
+// intDigits 整数部分的位数，不含前导0
+func (d decimal) intDigits() int {
+	n := len(new(big.Int).Abs(d.unscaled).String()) - d.scale
+	if n < 0 || d.unscaled.Sign() == 0 {
+		return 0
+	}
+	return n
+}
+
+// rat 转为*big.Rat
+func (d decimal) rat() *big.Rat {
+	if d.unscaled == nil {
+		return nil
+	}
+	return new(big.Rat).SetFrac(d.unscaled, pow10(d.scale))
+}
+
+// format 格式化为小数字符串，scale大于实际小数位数时补齐尾部的0
+func (d decimal) format(scale int) string {
+	if scale < d.scale {
+		scale = d.scale
+	}
+	digits := new(big.Int).Abs(new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))).String()
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// pow10 10的n次方
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
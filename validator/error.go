@@ -0,0 +1,13 @@
+package validator
+
+// FieldError 字段校验错误
+type FieldError struct {
+	Field string
+	Code  int32
+	Msg   string
+}
+
+// Error 错误信息
+func (e *FieldError) Error() string {
+	return e.Msg
+}
@@ -0,0 +1,240 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+)
+
+// pathKey 嵌套校验时父级参数路径的context key
+type pathKey struct{}
+
+// nestedResult 嵌套校验失败结果
+// err不为空时为嵌套Filter的错误，否则为数组元素path的规则校验结果res，错误信息由外层Filter处理
+type nestedResult struct {
+	err   *FieldError
+	path  string
+	value interface{}
+	res   ValidateResult
+}
+
+// Stat 校验结果
+func (r nestedResult) Stat(ctx context.Context) ValidateStatus {
+	return VS_FAILUE
+}
+
+// ErrMsg 校验错误
+func (r nestedResult) ErrMsg(ctx context.Context) string {
+	if r.err != nil {
+		return r.err.Msg
+	}
+	return r.res.ErrMsg(ctx)
+}
+
+// NestedFail 嵌套校验失败，err的字段路径和错误码原样返回，错误码为0时使用外层Filter的错误码
+func NestedFail(err *FieldError) ValidateResult {
+	return nestedResult{err: err}
+}
+
+// WithPath 设置嵌套校验的父级参数路径
+func WithPath(ctx context.Context, path string) context.Context {
+	return context.WithValue(ctx, pathKey{}, path)
+}
+
+// ValidateFilter 执行单个Filter的全部规则，校验结果写入vRes
+func ValidateFilter(ctx context.Context, filter Filter, params map[string]interface{}, vRes map[string]interface{}) *FieldError {
+	key := filter.Key(ctx)
+	paramVal, ok := params[key]
+	if !ok {
+		paramVal = nil
+	}
+	path := key
+	if prefix, _ := ctx.Value(pathKey{}).(string); prefix != "" {
+		path = prefix + "." + key
+	}
+	opts := &ValidateOptions{
+		Key:    key,
+		Value:  paramVal,
+		Params: params,
+		Ctx:    ctx,
+		Path:   path,
+	}
+	if tm, ok := filter.(TypeModer); ok {
+		opts.TypeMode = tm.TypeMode(ctx)
+	}
+	for _, fn := range filter.Rules(ctx) {
+		res := fn(opts)
+		if res.Stat(ctx) == VS_BREAK {
+			break
+		}
+		if res.Stat(ctx) == VS_FAILUE {
+			return failError(ctx, filter, path, opts.Value, res)
+		}
+	}
+	// 记录校验结果
+	if opts.Value != nil && opts.Key != "-" {
+		vRes[opts.Key] = opts.Value
+	}
+	// 记录扩展数据
+	if opts.Extend != nil {
+		for ek, ev := range opts.Extend {
+			vRes[ek] = ev
+		}
+	}
+	return nil
+}
+
+// failError 规则校验失败时的错误
+// 错误信息依次取规则的错误信息、Filter的错误信息、默认错误信息
+// 错误信息中的{field}为Filter的显示名称，未设置时为参数路径，{path}始终为参数路径
+func failError(ctx context.Context, filter Filter, path string, value interface{}, res ValidateResult) *FieldError {
+	name := path
+	if l, ok := filter.(Labeler); ok {
+		if label := l.Label(ctx); label != "" {
+			name = label
+		}
+	}
+	if nr, ok := res.(nestedResult); ok {
+		if nr.err != nil {
+			ferr := *nr.err
+			if ferr.Code == 0 {
+				ferr.Code = filter.ErrCode(ctx)
+			}
+			return &ferr
+		}
+		path, value, res = nr.path, nr.value, nr.res
+	}
+	key, args := resultMsgKey(res)
+	margs := make(map[string]interface{}, len(args)+3)
+	margs["field"] = name
+	margs["path"] = path
+	if value != nil {
+		margs["value"] = value
+	}
+	for k, v := range args {
+		margs[k] = v
+	}
+	ferr := &FieldError{
+		Field: path,
+		Code:  resultCode(res),
+	}
+	if ferr.Code == 0 {
+		ferr.Code = filter.ErrCode(ctx)
+	}
+	switch r := res.(type) {
+	case MultiLangValidateResult:
+		if r.Emsg != "" {
+			ferr.Msg = LocalizeArgs(ctx, r.Emsg, margs)
+		}
+	case NormalValidateResult:
+		ferr.Msg = r.Emsg
+		if ferr.Msg != "" && localizeMode(ctx, filterMessageMode(ctx, filter)) {
+			ferr.Msg = LocalizeArgs(ctx, ferr.Msg, margs)
+		}
+	default:
+		ferr.Msg = res.ErrMsg(ctx)
+	}
+	if ferr.Msg == "" {
+		if f, ok := filter.(interface {
+			FormatErrMsg(ctx context.Context, args map[string]interface{}) string
+		}); ok {
+			ferr.Msg = f.FormatErrMsg(ctx, margs)
+		} else {
+			ferr.Msg = filter.ErrMsg(ctx)
+		}
+	}
+	if ferr.Msg == "" {
+		ferr.Msg = defaultErrMsg(ctx, name, key, margs)
+	}
+	return ferr
+}
+
+// resultMsgKey 规则校验结果的默认错误信息KEY及占位参数
+func resultMsgKey(res ValidateResult) (string, map[string]interface{}) {
+	switch r := res.(type) {
+	case NormalValidateResult:
+		return r.Key, r.Args
+	case MultiLangValidateResult:
+		return r.Key, r.Args
+	}
+	return "", nil
+}
+
+// resultCode 规则校验结果的错误码
+func resultCode(res ValidateResult) int32 {
+	switch r := res.(type) {
+	case NormalValidateResult:
+		return r.Code
+	case MultiLangValidateResult:
+		return r.Code
+	}
+	return 0
+}
+
+// ValidateFilters 依次执行rules，遇到首个错误时中断
+func ValidateFilters(ctx context.Context, params map[string]interface{}, rules []Filter) (map[string]interface{}, *FieldError) {
+	vRes := make(map[string]interface{})
+	for _, filter := range rules {
+		if ferr := ValidateFilter(ctx, filter, params, vRes); ferr != nil {
+			return vRes, ferr
+		}
+	}
+	return vRes, nil
+}
+
+// Nested 参数为对象，按rules校验对象内的字段，参数值替换为校验结果
+func Nested(rules []Filter, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		obj, ok := opts.Value.(map[string]interface{})
+		if !ok {
+			return FailMsg(emsg, "object", nil)
+		}
+		res, ferr := ValidateFilters(WithPath(opts.Context(), opts.Path), obj, rules)
+		if ferr != nil {
+			return NestedFail(ferr)
+		}
+		opts.Value = res
+		return Succ()
+	}
+}
+
+// Each 参数为数组，每个元素依次执行rules，参数值替换为各元素的校验结果
+// 元素的参数路径为 父级路径.下标，元素校验结果为nil时保留为nil
+func Each(rules []Validator, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		rv := reflect.ValueOf(opts.Value)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return FailMsg(emsg, "array", nil)
+		}
+		ctx := opts.Context()
+		vals := make([]interface{}, rv.Len())
+		for idx := 0; idx < rv.Len(); idx++ {
+			eopts := &ValidateOptions{
+				Key:      strconv.Itoa(idx),
+				Value:    rv.Index(idx).Interface(),
+				Params:   opts.Params,
+				Ctx:      ctx,
+				Path:     opts.Path + "." + strconv.Itoa(idx),
+				TypeMode: opts.TypeMode,
+			}
+			for _, fn := range rules {
+				res := fn(eopts)
+				if res.Stat(ctx) == VS_BREAK {
+					break
+				}
+				if res.Stat(ctx) == VS_FAILUE {
+					if _, ok := res.(nestedResult); ok {
+						return res
+					}
+					if res.ErrMsg(ctx) == "" && len(emsg) > 0 {
+						res = Fail(emsg)
+					}
+					return nestedResult{path: eopts.Path, value: eopts.Value, res: res}
+				}
+			}
+			vals[idx] = eopts.Value
+		}
+		opts.Value = vals
+		return Succ()
+	}
+}
@@ -19,6 +19,33 @@ type ValidateOptions struct {
 	Value  interface{}
 	Params map[string]interface{}
 	Extend map[string]interface{}
+	// Ctx 本次校验的上下文
+	Ctx context.Context
+	// Path 参数的完整路径，嵌套参数以英文句点连接
+	Path string
+	// TypeMode Filter的类型模式，为TM_DEFAULT时跟随校验配置
+	TypeMode TypeMode
+	// dateOnly 参数值为Time规则按不含时分秒的格式解析的日期，相对时间规则按天比较
+	dateOnly bool
+}
+
+// Context 本次校验的上下文，未设置时为context.Background()
+func (opts *ValidateOptions) Context() context.Context {
+	if opts.Ctx == nil {
+		return context.Background()
+	}
+	return opts.Ctx
+}
+
+// Strict 是否为严格类型模式
+func (opts *ValidateOptions) Strict() bool {
+	mode := opts.TypeMode
+	if mode == TM_DEFAULT && opts.Ctx != nil {
+		if cfg := ConfigFrom(opts.Ctx); cfg != nil {
+			mode = cfg.TypeMode
+		}
+	}
+	return mode == TM_STRICT
 }
 
 // ValidateResult 规则校验结果
@@ -31,6 +58,11 @@ type ValidateResult interface {
 type NormalValidateResult struct {
 	Status ValidateStatus
 	Emsg   string
+	// Key 默认错误信息的消息KEY，Args为其占位参数
+	Key  string
+	Args map[string]interface{}
+	// Code 规则的错误码，为0时使用Filter的错误码
+	Code int32
 }
 
 // Stat 校验结果
@@ -47,6 +79,11 @@ func (r NormalValidateResult) ErrMsg(ctx context.Context) string {
 type MultiLangValidateResult struct {
 	Status ValidateStatus
 	Emsg   string
+	// Key 默认错误信息的消息KEY，Args为其占位参数
+	Key  string
+	Args map[string]interface{}
+	// Code 规则的错误码，为0时使用Filter的错误码
+	Code int32
 }
 
 // Stat 校验结果
@@ -54,18 +91,12 @@ func (r MultiLangValidateResult) Stat(ctx context.Context) ValidateStatus {
 	return r.Status
 }
 
-// ErrMsg 校验错误
+// ErrMsg 校验错误，Localizer实现了ArgsLocalizer时使用Args翻译
 func (r MultiLangValidateResult) ErrMsg(ctx context.Context) string {
 	if r.Emsg == "" {
 		return ""
 	}
-	ilocalizer := ctx.Value(localizerKey)
-	localizer, ok := ilocalizer.(Localizer)
-	if !ok {
-		return r.Emsg
-	}
-	msg := localizer.Localize(r.Emsg)
-	return msg
+	return LocalizeArgs(ctx, r.Emsg, r.Args)
 }
 
 // Filter 参数校验规则器
@@ -76,12 +107,56 @@ type Filter interface {
 	Rules(ctx context.Context) []Validator
 }
 
+// MessageMode 错误信息模式
+type MessageMode int8
+
+const (
+	// MM_DEFAULT 跟随校验配置，未配置时为MM_PLAIN
+	MM_DEFAULT MessageMode = 0
+	// MM_PLAIN 错误信息原样返回
+	MM_PLAIN MessageMode = 1
+	// MM_LOCALIZE 错误信息作为消息ID，由context中的Localizer翻译
+	MM_LOCALIZE MessageMode = 2
+)
+
+// MessageModer 指定错误信息模式的Filter
+type MessageModer interface {
+	MessageMode(ctx context.Context) MessageMode
+}
+
+// Labeler 提供参数显示名称的Filter，显示名称作为错误信息中的{field}
+type Labeler interface {
+	Label(ctx context.Context) string
+}
+
+// TypeMode 类型模式
+type TypeMode int8
+
+const (
+	// TM_DEFAULT 跟随校验配置，未配置时为TM_COERCE
+	TM_DEFAULT TypeMode = 0
+	// TM_COERCE 宽松模式，字符串等类型转换为规则要求的类型，适用于查询字符串
+	TM_COERCE TypeMode = 1
+	// TM_STRICT 严格模式，仅接受规则要求的类型，适用于JSON参数
+	// 整数仅接受整数类型及整数形式的json.Number，浮点数即使没有小数部分也校验失败，JSON参数需以json.Decoder.UseNumber解码
+	// 字符串不转换为数字或布尔值，空字符串不视为0
+	TM_STRICT TypeMode = 2
+)
+
+// TypeModer 指定类型模式的Filter
+type TypeModer interface {
+	TypeMode(ctx context.Context) TypeMode
+}
+
 // NormalFilter 校验规则结构
 type NormalFilter struct {
 	key     string
 	rules   []Validator
 	errMsg  string
 	errCode int32
+	mode    MessageMode
+	label   string
+	types   TypeMode
 }
 
 // NewNormalFilter
@@ -99,8 +174,26 @@ func (f NormalFilter) Key(ctx context.Context) string {
 	return f.key
 }
 
-// ErrMsg 获取错误信息
+// ErrMsg 获取错误信息，多语言模式下由context中的Localizer翻译
 func (f NormalFilter) ErrMsg(ctx context.Context) string {
+	if f.errMsg == "" {
+		return ""
+	}
+	if localizeMode(ctx, f.mode) {
+		return Localize(ctx, f.errMsg)
+	}
+	return f.errMsg
+}
+
+// FormatErrMsg 获取错误信息，多语言模式下由context中的Localizer翻译并替换占位符
+// 非多语言模式下错误信息原样返回，其中的{}不视为占位符
+func (f NormalFilter) FormatErrMsg(ctx context.Context, args map[string]interface{}) string {
+	if f.errMsg == "" {
+		return ""
+	}
+	if localizeMode(ctx, f.mode) {
+		return LocalizeArgs(ctx, f.errMsg, args)
+	}
 	return f.errMsg
 }
 
@@ -114,51 +207,82 @@ func (f NormalFilter) Rules(ctx context.Context) []Validator {
 	return f.rules
 }
 
-// MultiLangFilter 支持多语言
+// MessageMode 错误信息模式
+func (f NormalFilter) MessageMode(ctx context.Context) MessageMode {
+	return f.mode
+}
+
+// WithMessageMode 设置错误信息模式
+func (f NormalFilter) WithMessageMode(mode MessageMode) NormalFilter {
+	f.mode = mode
+	return f
+}
+
+// Label 参数显示名称，由context中的Localizer翻译，未设置时为空
+func (f NormalFilter) Label(ctx context.Context) string {
+	if f.label == "" {
+		return ""
+	}
+	return Localize(ctx, f.label)
+}
+
+// WithLabel 设置参数显示名称的消息ID
+func (f NormalFilter) WithLabel(label string) NormalFilter {
+	f.label = label
+	return f
+}
+
+// TypeMode 类型模式
+func (f NormalFilter) TypeMode(ctx context.Context) TypeMode {
+	return f.types
+}
+
+// WithTypeMode 设置类型模式
+func (f NormalFilter) WithTypeMode(mode TypeMode) NormalFilter {
+	f.types = mode
+	return f
+}
+
+// MultiLangFilter 支持多语言，即错误信息模式为MM_LOCALIZE的NormalFilter
 type MultiLangFilter struct {
-	key     string
-	rules   []Validator
-	errMsg  string
-	errCode int32
+	NormalFilter
 }
 
 // NewMultiLangFilter
 func NewMultiLangFilter(k string, rules []Validator, err string, code int32) MultiLangFilter {
 	return MultiLangFilter{
-		key:     k,
-		rules:   rules,
-		errMsg:  err,
-		errCode: code,
+		NormalFilter: NewNormalFilter(k, rules, err, code).WithMessageMode(MM_LOCALIZE),
 	}
 }
 
-// Key 获取参数KEY
-func (f MultiLangFilter) Key(ctx context.Context) string {
-	return f.key
+// WithLabel 设置参数显示名称的消息ID
+func (f MultiLangFilter) WithLabel(label string) MultiLangFilter {
+	f.NormalFilter = f.NormalFilter.WithLabel(label)
+	return f
 }
 
-// ErrMsg 获取错误信息
-func (f MultiLangFilter) ErrMsg(ctx context.Context) string {
-	if f.errMsg == "" {
-		return ""
-	}
-	ilocalizer := ctx.Value(localizerKey)
-	localizer, ok := ilocalizer.(Localizer)
-	if !ok {
-		return f.errMsg
-	}
-	msg := localizer.Localize(f.errMsg)
-	return msg
+// WithTypeMode 设置类型模式
+func (f MultiLangFilter) WithTypeMode(mode TypeMode) MultiLangFilter {
+	f.NormalFilter = f.NormalFilter.WithTypeMode(mode)
+	return f
 }
 
-// ErrCode 获取错误码
-func (f MultiLangFilter) ErrCode(ctx context.Context) int32 {
-	return f.errCode
+// localizeMode 是否按多语言处理错误信息，mode为MM_DEFAULT时跟随校验配置
+func localizeMode(ctx context.Context, mode MessageMode) bool {
+	if mode == MM_DEFAULT {
+		if cfg := ConfigFrom(ctx); cfg != nil {
+			mode = cfg.MessageMode
+		}
+	}
+	return mode == MM_LOCALIZE
 }
 
-// GetRules 获取所有规则
-func (f MultiLangFilter) Rules(ctx context.Context) []Validator {
-	return f.rules
+// filterMessageMode Filter的错误信息模式
+func filterMessageMode(ctx context.Context, filter Filter) MessageMode {
+	if m, ok := filter.(MessageModer); ok {
+		return m.MessageMode(ctx)
+	}
+	return MM_DEFAULT
 }
 
 // Validator 规则
@@ -183,6 +307,20 @@ func Fail(emsg []string) ValidateResult {
 	return result
 }
 
+// FailMsg 规则校验失败
+// 未设置错误信息时，使用消息KEY对应的默认错误信息，args为默认错误信息的占位参数
+func FailMsg(emsg []string, key string, args map[string]interface{}) ValidateResult {
+	result := NormalValidateResult{
+		Status: VS_FAILUE,
+		Key:    key,
+		Args:   args,
+	}
+	if len(emsg) > 0 {
+		result.Emsg = emsg[0]
+	}
+	return result
+}
+
 // Fail 规则校验失败
 func FailMultiLang(emsg []string) ValidateResult {
 	result := MultiLangValidateResult{
@@ -194,6 +332,23 @@ func FailMultiLang(emsg []string) ValidateResult {
 	return result
 }
 
+// MultiLang 规则的错误信息始终按多语言处理，不受Filter及校验配置的错误信息模式影响
+func MultiLang(rule Validator) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		res := rule(opts)
+		if r, ok := res.(NormalValidateResult); ok && r.Status == VS_FAILUE {
+			return MultiLangValidateResult{
+				Status: r.Status,
+				Emsg:   r.Emsg,
+				Key:    r.Key,
+				Args:   r.Args,
+				Code:   r.Code,
+			}
+		}
+		return res
+	}
+}
+
 // Break 中断后续校验流程
 func Break() ValidateResult {
 	return NormalValidateResult{
@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"github.com/rumis/govalidate/executor"
+	"github.com/rumis/govalidate/utils"
+)
+
+// FloatBetween 浮点值范围限制 [min,max]，参数值不变
+func FloatBetween(min float64, max float64, emsg ...string) Validator {
+	return floatRule(executor.FloatBetween(min, max), emsg, "between", map[string]interface{}{"min": min, "max": max})
+}
+
+// Min 浮点值不小于min，参数值不变
+func Min(min float64, emsg ...string) Validator {
+	return floatRule(executor.FloatMin(min), emsg, "min", map[string]interface{}{"min": min})
+}
+
+// Max 浮点值不大于max，参数值不变
+func Max(max float64, emsg ...string) Validator {
+	return floatRule(executor.FloatMax(max), emsg, "max", map[string]interface{}{"max": max})
+}
+
+// Positive 大于0，参数值不变
+func Positive(emsg ...string) Validator {
+	return floatRule(executor.Positive, emsg, "positive", nil)
+}
+
+// NonNegative 大于等于0，参数值不变
+func NonNegative(emsg ...string) Validator {
+	return floatRule(executor.NonNegative, emsg, "nonnegative", nil)
+}
+
+// MultipleOf 为step的整数倍，参数值不变
+func MultipleOf(step float64, emsg ...string) Validator {
+	return floatRule(executor.MultipleOf(step), emsg, "multiple", map[string]interface{}{"step": step})
+}
+
+// MaxDecimals 小数位数不超过n，参数值不变
+func MaxDecimals(n int, emsg ...string) Validator {
+	return floatRule(executor.MaxDecimals(n), emsg, "decimals", map[string]interface{}{"max": n})
+}
+
+// floatRule 浮点值规则，参数值按float64比较但不替换，Int、Int64、Decimal等规则的结果保持原类型
+// NaN及正负无穷校验失败
+func floatRule(exe executor.FloatExecutor, emsg []string, key string, args map[string]interface{}) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		val, ok := floatValue(opts)
+		if !ok || !exe(val) {
+			return FailMsg(emsg, key, args)
+		}
+		return Succ()
+	}
+}
+
+// FloatSlice 浮点数组，参数同IntSlice，校验条件为executor.FloatExecutor
+// 元素为NaN及正负无穷时校验失败
+func FloatSlice(msgExecutor ...interface{}) Validator {
+	var errMsgs []string
+	execs := []executor.FloatExecutor{executor.Finite}
+	for _, p := range msgExecutor {
+		switch p := p.(type) {
+		case string:
+			errMsgs = append(errMsgs, p)
+		case executor.FloatExecutor:
+			execs = append(execs, p)
+		case []executor.FloatExecutor:
+			execs = append(execs, p...)
+		}
+	}
+	return func(opts *ValidateOptions) ValidateResult {
+		vals, ok := utils.GetFloatSlice(opts.Value)
+		if !ok {
+			return FailMsg(errMsgs, "floatslice", nil)
+		}
+		for _, exe := range execs {
+			for _, val := range vals {
+				if !exe(val) {
+					return FailMsg(errMsgs, "floatslice", nil)
+				}
+			}
+		}
+		opts.Value = vals
+		return Succ()
+	}
+}
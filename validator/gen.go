@@ -0,0 +1,116 @@
+package validator
+
+import (
+	"context"
+	"math"
+
+	"github.com/rumis/govalidate/utils"
+)
+
+// GenContext govalidate-gen生成代码使用的校验上下文
+// 类型转换与Int、String等规则一致，错误信息与Filter校验失败时一致
+type GenContext struct {
+	ctx  context.Context
+	opts ValidateOptions
+}
+
+// NewGenContext 创建生成代码使用的校验上下文
+func NewGenContext(ctx context.Context) GenContext {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return GenContext{
+		ctx:  ctx,
+		opts: ValidateOptions{Ctx: ctx},
+	}
+}
+
+// Context 校验使用的context
+func (g GenContext) Context() context.Context {
+	return g.ctx
+}
+
+// Strict 是否为严格类型模式
+func (g GenContext) Strict() bool {
+	return g.opts.Strict()
+}
+
+// Int 转为int，同Int规则
+func (g GenContext) Int(val interface{}) (int, bool) {
+	g.opts.Value = val
+	return intValue(&g.opts)
+}
+
+// Int64 转为int64，同Int64规则
+func (g GenContext) Int64(val interface{}) (int64, bool) {
+	g.opts.Value = val
+	return int64Value(&g.opts)
+}
+
+// Int32 转为int32，同Int32规则
+func (g GenContext) Int32(val interface{}) (int32, bool) {
+	v, ok := g.Int64(val)
+	if !ok || v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(v), true
+}
+
+// Uint64 转为uint64，同Uint64规则
+func (g GenContext) Uint64(val interface{}) (uint64, bool) {
+	g.opts.Value = val
+	return uint64Value(&g.opts)
+}
+
+// Uint32 转为uint32，同Uint32规则
+func (g GenContext) Uint32(val interface{}) (uint32, bool) {
+	v, ok := g.Uint64(val)
+	if !ok || v > math.MaxUint32 {
+		return 0, false
+	}
+	return uint32(v), true
+}
+
+// Float 转为float64，同Float规则
+func (g GenContext) Float(val interface{}) (float64, bool) {
+	g.opts.Value = val
+	return floatValue(&g.opts)
+}
+
+// String 转为字符串，同String规则
+func (g GenContext) String(val interface{}) (string, bool) {
+	g.opts.Value = val
+	return stringValue(&g.opts)
+}
+
+// Boolean 转为布尔值，同Boolean规则
+func (g GenContext) Boolean(val interface{}) (bool, bool) {
+	g.opts.Value = val
+	return booleanValue(&g.opts)
+}
+
+// BooleanWith 按词表转为布尔值，同BooleanWith规则
+func (g GenContext) BooleanWith(vocab *utils.BoolVocab, val interface{}) (bool, bool) {
+	if g.opts.Strict() {
+		return utils.GetBooleanStrict(val)
+	}
+	return vocab.Parse(val)
+}
+
+// Path 参数的完整路径
+func (g GenContext) Path(key string) string {
+	if prefix, _ := g.ctx.Value(pathKey{}).(string); prefix != "" {
+		return prefix + "." + key
+	}
+	return key
+}
+
+// Nested 嵌套对象校验使用的context
+func (g GenContext) Nested(key string) context.Context {
+	return WithPath(g.ctx, g.Path(key))
+}
+
+// Fail 规则校验失败时的错误，value为失败时的参数值
+func (g GenContext) Fail(filter Filter, value interface{}, res ValidateResult) *FieldError {
+	return failError(g.ctx, filter, g.Path(filter.Key(g.ctx)), value, res)
+}
@@ -0,0 +1,123 @@
+package validator
+
+import (
+	"math"
+
+	"github.com/rumis/govalidate/executor"
+	"github.com/rumis/govalidate/utils"
+)
+
+// Int64 参数为int64整数，超出范围时校验失败
+func Int64(emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		v, ok := int64Value(opts)
+		if !ok {
+			return FailMsg(emsg, "int_range", map[string]interface{}{"min": int64(math.MinInt64), "max": int64(math.MaxInt64)})
+		}
+		opts.Value = v
+		return Succ()
+	}
+}
+
+// Uint64 参数为uint64整数，负数或超出范围时校验失败
+func Uint64(emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		v, ok := uint64Value(opts)
+		if !ok {
+			return FailMsg(emsg, "int_range", map[string]interface{}{"min": 0, "max": uint64(math.MaxUint64)})
+		}
+		opts.Value = v
+		return Succ()
+	}
+}
+
+// Int32 参数为int32整数，超出范围时校验失败
+func Int32(emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		v, ok := int64Value(opts)
+		if !ok || v < math.MinInt32 || v > math.MaxInt32 {
+			return FailMsg(emsg, "int_range", map[string]interface{}{"min": math.MinInt32, "max": math.MaxInt32})
+		}
+		opts.Value = int32(v)
+		return Succ()
+	}
+}
+
+// Uint32 参数为uint32整数，负数或超出范围时校验失败
+func Uint32(emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		v, ok := uint64Value(opts)
+		if !ok || v > math.MaxUint32 {
+			return FailMsg(emsg, "int_range", map[string]interface{}{"min": 0, "max": uint32(math.MaxUint32)})
+		}
+		opts.Value = uint32(v)
+		return Succ()
+	}
+}
+
+// Between64 int64数字值范围限制 [min,max]，参数值转为int64
+func Between64(min int64, max int64, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		val, ok := int64Value(opts)
+		if !ok || !executor.Between64(min, max)(val) {
+			return FailMsg(emsg, "between", map[string]interface{}{"min": min, "max": max})
+		}
+		opts.Value = val
+		return Succ()
+	}
+}
+
+// BetweenUint64 uint64数字值范围限制 [min,max]，参数值转为uint64
+func BetweenUint64(min uint64, max uint64, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		val, ok := uint64Value(opts)
+		if !ok || !executor.BetweenUint64(min, max)(val) {
+			return FailMsg(emsg, "between", map[string]interface{}{"min": min, "max": max})
+		}
+		opts.Value = val
+		return Succ()
+	}
+}
+
+// EnumInt64 枚举，值类型为int64
+func EnumInt64(enums []int64, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		val, ok := int64Value(opts)
+		if !ok || !executor.EnumInt64(enums)(val) {
+			return FailMsg(emsg, "enum", map[string]interface{}{"enum": enums})
+		}
+		opts.Value = val
+		return Succ()
+	}
+}
+
+// Int64Slice int64数组，参数同IntSlice，校验条件为executor.Int64Executor
+func Int64Slice(msgExecutor ...interface{}) Validator {
+	var errMsgs []string
+	var execs []executor.Int64Executor
+	for _, p := range msgExecutor {
+		switch p := p.(type) {
+		case string:
+			errMsgs = append(errMsgs, p)
+		case executor.Int64Executor:
+			execs = append(execs, p)
+		case []executor.Int64Executor:
+			execs = append(execs, p...)
+		}
+	}
+	return func(opts *ValidateOptions) ValidateResult {
+		vals, ok := utils.GetInt64Slice(opts.Value)
+		if !ok {
+			return FailMsg(errMsgs, "intslice", nil)
+		}
+		for _, exe := range execs {
+			for _, val := range vals {
+				if !exe(val) {
+					return FailMsg(errMsgs, "intslice", nil)
+				}
+			}
+		}
+		opts.Value = vals
+		return Succ()
+	}
+}
@@ -1,10 +1,18 @@
 package validator
 
+import "context"
+
 // Localizer ..
 type Localizer interface {
 	Localize(id string) string
 }
 
+// ArgsLocalizer 支持参数的Localizer，args用于消息中的占位符及复数形式
+type ArgsLocalizer interface {
+	Localizer
+	LocalizeWith(id string, args map[string]interface{}) string
+}
+
 var localizerKey string = "i18n-localizer-key"
 
 // InitLocalizerKey 初始化Localizer Key
@@ -16,3 +24,42 @@ func InitLocalizerKey(lkey string) {
 func GetLocalizerKey() string {
 	return localizerKey
 }
+
+// LocalizerFrom 获取context中的Localizer
+// 优先使用校验配置中的Localizer，其次按配置的LocalizerKey查找，最后按全局的Localizer Key查找
+func LocalizerFrom(ctx context.Context) (Localizer, bool) {
+	var key interface{} = localizerKey
+	if cfg := ConfigFrom(ctx); cfg != nil {
+		if cfg.Localizer != nil {
+			localizer := cfg.Localizer(ctx)
+			return localizer, localizer != nil
+		}
+		if cfg.LocalizerKey != nil {
+			key = cfg.LocalizerKey
+		}
+	}
+	localizer, ok := ctx.Value(key).(Localizer)
+	return localizer, ok
+}
+
+// Localize 使用context中的Localizer翻译，不存在Localizer时原样返回
+func Localize(ctx context.Context, id string) string {
+	localizer, ok := LocalizerFrom(ctx)
+	if !ok {
+		return id
+	}
+	return localizer.Localize(id)
+}
+
+// LocalizeArgs 使用context中的Localizer翻译并替换占位符，不存在Localizer时原样返回
+// Localizer实现了ArgsLocalizer时由其处理args，否则在翻译结果中替换占位符
+func LocalizeArgs(ctx context.Context, id string, args map[string]interface{}) string {
+	localizer, ok := LocalizerFrom(ctx)
+	if !ok {
+		return id
+	}
+	if al, ok := localizer.(ArgsLocalizer); ok {
+		return al.LocalizeWith(id, args)
+	}
+	return FormatMessage(localizer.Localize(id), args)
+}
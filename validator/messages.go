@@ -0,0 +1,251 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rumis/govalidate/utils"
+)
+
+// DefaultLang 默认错误信息未指定语言时使用的语言
+const DefaultLang = "en"
+
+// MessagePrefix 默认错误信息在Localizer中的消息ID前缀，如 govalidate.required
+const MessagePrefix = "govalidate."
+
+// langKey 本次校验语言的context key
+type langKey struct{}
+
+// Languager 可提供当前语言的Localizer
+type Languager interface {
+	Language() string
+}
+
+var messagesMu sync.RWMutex
+
+// defaultMessages 内置默认错误信息，语言 -> 消息KEY -> 消息模板
+// 模板占位符：{field} 参数名，{value} 参数值，{min} {max} {enum} 等为规则参数
+var defaultMessages = map[string]map[string]string{
+	"zh-CN": {
+		"required":          "{field}不能为空",
+		"int":               "{field}必须为整数",
+		"float":             "{field}必须为数字",
+		"string":            "{field}必须为非空字符串",
+		"boolean":           "{field}必须为布尔值",
+		"type":              "{field}的类型必须为{type}",
+		"email":             "{field}不是有效的邮箱地址",
+		"url":               "{field}不是有效的URL",
+		"phone":             "{field}不是有效的手机号码",
+		"ipv4":              "{field}不是有效的IPv4地址",
+		"date":              "{field}必须为日期，格式为2006-01-02",
+		"datetime":          "{field}必须为时间，格式为2006-01-02 15:04:05",
+		"rfc3339":           "{field}必须为RFC3339格式的时间",
+		"time":              "{field}不是有效的时间",
+		"before":            "{field}必须早于{time}",
+		"after":             "{field}必须晚于{time}",
+		"not_future":        "{field}不能晚于当前时间",
+		"not_past":          "{field}不能早于当前时间",
+		"within_last":       "{field}必须在过去{duration}以内",
+		"within_next":       "{field}必须在未来{duration}以内",
+		"date_range_time":   "{start}和{end}必须为有效的时间",
+		"date_range":        "{start}不能晚于{end}",
+		"date_range_min":    "{start}到{end}的间隔不能少于{span}",
+		"date_range_max":    "{start}到{end}的间隔不能超过{span}",
+		"length":            "{field}的长度必须在{min}到{max}之间",
+		"between":           "{field}必须在{min}到{max}之间",
+		"int_range":         "{field}必须为{min}到{max}之间的整数",
+		"min":               "{field}不能小于{min}",
+		"max":               "{field}不能大于{max}",
+		"positive":          "{field}必须大于0",
+		"nonnegative":       "{field}不能小于0",
+		"multiple":          "{field}必须为{step}的整数倍",
+		"decimals":          "{field}最多保留{max}位小数",
+		"decimal":           "{field}必须为有效的数字",
+		"decimal_scale":     "{field}最多保留{scale}位小数",
+		"decimal_precision": "{field}超出精度，最多{precision}位数字，其中小数{scale}位",
+		"decimal_config":    "{field}的规则配置错误：{reason}",
+		"enum":              "{field}必须为以下值之一：{enum}",
+		"dotint":            "{field}必须为英文逗号分隔的正整数",
+		"maxdot":            "{field}最多包含{max}个值",
+		"regex":             "{field}格式不正确",
+		"format":            "{field}不是有效的{type}",
+		"intslice":          "{field}必须为整数数组",
+		"stringslice":       "{field}必须为字符串数组",
+		"floatslice":        "{field}必须为数字数组",
+		"object":            "{field}必须为对象",
+		"array":             "{field}必须为数组",
+		"union":             "{field}的类型{type}不受支持",
+		"schema":            "{field}的规则集{name}未定义",
+		"depth":             "{field}的嵌套层级超过{max}",
+		"unknown":           "不允许的参数{field}",
+		"max_params":        "参数个数{value}超过上限{max}",
+	},
+	"en": {
+		"required":          "{field} is required",
+		"int":               "{field} must be an integer",
+		"float":             "{field} must be a number",
+		"string":            "{field} must be a non-empty string",
+		"boolean":           "{field} must be a boolean",
+		"type":              "{field} must be of type {type}",
+		"email":             "{field} must be a valid email address",
+		"url":               "{field} must be a valid URL",
+		"phone":             "{field} must be a valid mobile phone number",
+		"ipv4":              "{field} must be a valid IPv4 address",
+		"date":              "{field} must be a date in format 2006-01-02",
+		"datetime":          "{field} must be a time in format 2006-01-02 15:04:05",
+		"rfc3339":           "{field} must be an RFC3339 time",
+		"time":              "{field} must be a valid time",
+		"before":            "{field} must be before {time}",
+		"after":             "{field} must be after {time}",
+		"not_future":        "{field} must not be in the future",
+		"not_past":          "{field} must not be in the past",
+		"within_last":       "{field} must be within the last {duration}",
+		"within_next":       "{field} must be within the next {duration}",
+		"date_range_time":   "{start} and {end} must be valid times",
+		"date_range":        "{start} must not be after {end}",
+		"date_range_min":    "the range from {start} to {end} must be at least {span}",
+		"date_range_max":    "the range from {start} to {end} must not exceed {span}",
+		"length":            "{field} must be between {min} and {max} characters",
+		"between":           "{field} must be between {min} and {max}",
+		"int_range":         "{field} must be an integer between {min} and {max}",
+		"min":               "{field} must be at least {min}",
+		"max":               "{field} must be at most {max}",
+		"positive":          "{field} must be greater than 0",
+		"nonnegative":       "{field} must not be negative",
+		"multiple":          "{field} must be a multiple of {step}",
+		"decimals":          "{field} must have at most {max} decimal places",
+		"decimal":           "{field} must be a valid decimal number",
+		"decimal_scale":     "{field} must have at most {scale} decimal places",
+		"decimal_precision": "{field} exceeds precision of {precision} digits with {scale} decimal places",
+		"decimal_config":    "{field} has a misconfigured rule: {reason}",
+		"enum":              "{field} must be one of {enum}",
+		"dotint":            "{field} must be comma separated positive integers",
+		"maxdot":            "{field} must contain at most {max} values",
+		"regex":             "{field} has an invalid format",
+		"format":            "{field} is not a valid {type}",
+		"intslice":          "{field} must be an array of integers",
+		"stringslice":       "{field} must be an array of strings",
+		"floatslice":        "{field} must be an array of numbers",
+		"object":            "{field} must be an object",
+		"array":             "{field} must be an array",
+		"union":             "{field} has an unsupported type {type}",
+		"schema":            "{field} refers to an undefined schema {name}",
+		"depth":             "{field} exceeds the max nesting depth {max}",
+		"unknown":           "field {field} is not allowed",
+		"max_params":        "too many params: {value} > {max}",
+	},
+}
+
+// WithLang 设置本次校验默认错误信息的语言
+func WithLang(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, langKey{}, lang)
+}
+
+// RegisterMessages 注册默认错误信息，已存在的消息KEY会被覆盖
+// 应在初始化阶段调用
+func RegisterMessages(lang string, msgs map[string]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	if _, ok := defaultMessages[lang]; !ok {
+		defaultMessages[lang] = make(map[string]string, len(msgs))
+	}
+	for k, v := range msgs {
+		defaultMessages[lang][k] = v
+	}
+}
+
+// Message 消息KEY对应的默认错误信息，未定义时返回空字符串
+// 优先使用context中的Localizer对消息ID MessagePrefix+key 的翻译，未翻译时使用内置的默认错误信息
+// 内置错误信息的语言依次取WithLang设置的语言、Localizer的Language()、校验配置的Lang、DefaultLang
+func Message(ctx context.Context, key string, args map[string]interface{}) string {
+	localizer, hasLocalizer := LocalizerFrom(ctx)
+	if hasLocalizer {
+		id := MessagePrefix + key
+		if al, ok := localizer.(ArgsLocalizer); ok {
+			if msg := al.LocalizeWith(id, args); msg != "" && msg != id {
+				return msg
+			}
+		} else if tpl := localizer.Localize(id); tpl != "" && tpl != id {
+			return FormatMessage(tpl, args)
+		}
+	}
+	lang, _ := ctx.Value(langKey{}).(string)
+	if lang == "" && hasLocalizer {
+		if l, ok := localizer.(Languager); ok {
+			lang = l.Language()
+		}
+	}
+	if lang == "" {
+		if cfg := ConfigFrom(ctx); cfg != nil {
+			lang = cfg.Lang
+		}
+	}
+	tpl := lookupMessage(lang, key)
+	if tpl == "" {
+		return ""
+	}
+	return FormatMessage(tpl, args)
+}
+
+// lookupMessage 查找内置错误信息，依次尝试语言本身、同一主语言的其他地区、DefaultLang
+func lookupMessage(lang string, key string) string {
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+	if tpl, ok := defaultMessages[lang][key]; ok {
+		return tpl
+	}
+	if lang != "" {
+		langs := make([]string, 0, len(defaultMessages))
+		for l := range defaultMessages {
+			langs = append(langs, l)
+		}
+		sort.Strings(langs)
+		base := BaseLang(lang)
+		for _, l := range langs {
+			if BaseLang(l) != base {
+				continue
+			}
+			if tpl, ok := defaultMessages[l][key]; ok {
+				return tpl
+			}
+		}
+	}
+	return defaultMessages[DefaultLang][key]
+}
+
+// BaseLang 主语言，如 zh-CN 为 zh
+func BaseLang(lang string) string {
+	return strings.ToLower(strings.SplitN(strings.Replace(lang, "_", "-", 1), "-", 2)[0])
+}
+
+// FormatMessage 替换消息模板中的占位符，如 {field}
+func FormatMessage(tpl string, args map[string]interface{}) string {
+	if len(args) == 0 || !strings.Contains(tpl, "{") {
+		return tpl
+	}
+	pairs := make([]string, 0, len(args)*2)
+	for k, v := range args {
+		pairs = append(pairs, "{"+k+"}", formatArg(v))
+	}
+	return strings.NewReplacer(pairs...).Replace(tpl)
+}
+
+// formatArg 占位参数格式化，数组以逗号分隔
+func formatArg(val interface{}) string {
+	if str, ok := utils.GetStringValue(val); ok {
+		return str
+	}
+	rv := reflect.ValueOf(val)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		items := make([]string, rv.Len())
+		for idx := range items {
+			items[idx] = formatArg(rv.Index(idx).Interface())
+		}
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprint(val)
+}
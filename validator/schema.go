@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultMaxDepth 命名规则集默认的最大引用深度
+const DefaultMaxDepth = 32
+
+// depthKey 规则集引用深度的context key
+type depthKey struct{}
+
+// Schemas 命名规则集，规则集之间可以相互引用或递归引用
+type Schemas struct {
+	mu       sync.RWMutex
+	sets     map[string][]Filter
+	maxDepth int
+}
+
+// NewSchemas 创建命名规则集，maxDepth为最大引用深度，默认为DefaultMaxDepth
+func NewSchemas(maxDepth ...int) *Schemas {
+	s := &Schemas{
+		sets:     make(map[string][]Filter),
+		maxDepth: DefaultMaxDepth,
+	}
+	if len(maxDepth) > 0 && maxDepth[0] > 0 {
+		s.maxDepth = maxDepth[0]
+	}
+	return s
+}
+
+// Define 定义规则集，同名时覆盖
+func (s *Schemas) Define(name string, rules []Filter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sets[name] = rules
+}
+
+// Get 获取规则集
+func (s *Schemas) Get(name string) ([]Filter, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rules, ok := s.sets[name]
+	return rules, ok
+}
+
+// Ref 参数为对象，按名为name的规则集校验，参数值替换为校验结果
+// 规则集在校验时查找，因此可以在定义之前引用，也可以在规则集内部引用自身
+// 引用深度超过上限或规则集未定义时校验失败，校验配置中的MaxDepth更小时以其为准
+func (s *Schemas) Ref(name string, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		obj, ok := opts.Value.(map[string]interface{})
+		if !ok {
+			return FailMsg(emsg, "object", nil)
+		}
+		rules, ok := s.Get(name)
+		if !ok {
+			return FailMsg(emsg, "schema", map[string]interface{}{"name": name})
+		}
+		ctx := opts.Context()
+		maxDepth := s.maxDepth
+		if cfg := ConfigFrom(ctx); cfg != nil && cfg.MaxDepth > 0 && cfg.MaxDepth < maxDepth {
+			maxDepth = cfg.MaxDepth
+		}
+		depth, _ := ctx.Value(depthKey{}).(int)
+		if depth >= maxDepth {
+			return FailMsg(emsg, "depth", map[string]interface{}{"max": maxDepth})
+		}
+		ctx = context.WithValue(WithPath(ctx, opts.Path), depthKey{}, depth+1)
+		res, ferr := ValidateFilters(ctx, obj, rules)
+		if ferr != nil {
+			return NestedFail(ferr)
+		}
+		opts.Value = res
+		return Succ()
+	}
+}
@@ -0,0 +1,220 @@
+package validator
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTimeLayouts Time规则未指定格式时依次尝试的格式
+var DefaultTimeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"}
+
+// DefaultLocation 默认时区Asia/Shanghai，系统缺少时区数据时为UTC+8
+var DefaultLocation = loadLocation("Asia/Shanghai", 8*60*60)
+
+// loadLocation 加载时区，失败时使用固定偏移的时区
+func loadLocation(name string, offset int) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.FixedZone(name, offset)
+	}
+	return loc
+}
+
+// TimeOptions Time规则的配置
+type TimeOptions struct {
+	// Layouts 依次尝试的时间格式，为空时使用DefaultTimeLayouts
+	Layouts []string
+	// Location 解析不含时区信息的时间时使用的时区，为nil时依次使用校验配置的Location、DefaultLocation
+	Location *time.Location
+}
+
+// Time 时间，依次按layouts解析，参数值替换为time.Time，如 Time("2006/01/02", time.RFC3339)
+// 不含时区信息的格式按校验配置的Location解析，未设置时为DefaultLocation
+func Time(layouts ...string) Validator {
+	return TimeWith(TimeOptions{Layouts: layouts})
+}
+
+// TimeWith 按配置解析时间，参数值替换为time.Time
+// 参数值为time.Time或*time.Time时直接通过
+func TimeWith(o TimeOptions, emsg ...string) Validator {
+	layouts := o.Layouts
+	if len(layouts) == 0 {
+		layouts = DefaultTimeLayouts
+	}
+	args := map[string]interface{}{"layouts": strings.Join(layouts, ", ")}
+	return func(opts *ValidateOptions) ValidateResult {
+		t, layout, ok := parseTime(opts, layouts, o.Location)
+		if !ok {
+			return FailMsg(emsg, "time", args)
+		}
+		if layout != "" {
+			opts.dateOnly = !hasClock(layout)
+		}
+		opts.Value = t
+		return Succ()
+	}
+}
+
+// parseTime 将参数值转为time.Time，字符串依次按layouts解析，loc为nil时使用校验配置的时区
+// 返回解析成功的格式，参数值为time.Time时为空
+func parseTime(opts *ValidateOptions, layouts []string, loc *time.Location) (time.Time, string, bool) {
+	switch v := opts.Value.(type) {
+	case time.Time:
+		return v, "", true
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, "", false
+		}
+		return *v, "", true
+	}
+	str, ok := stringValue(opts)
+	if !ok {
+		return time.Time{}, "", false
+	}
+	if loc == nil {
+		loc = location(opts.Ctx)
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, str, loc); err == nil {
+			return t, layout, true
+		}
+	}
+	return time.Time{}, "", false
+}
+
+// hasClock 格式是否包含时分秒，时、分、秒的格式元素均含数字3、4或5，日期元素不含
+func hasClock(layout string) bool {
+	return strings.ContainsAny(layout, "345")
+}
+
+// location 校验配置的时区
+func location(ctx context.Context) *time.Location {
+	if ctx != nil {
+		if cfg := ConfigFrom(ctx); cfg != nil && cfg.Location != nil {
+			return cfg.Location
+		}
+	}
+	return DefaultLocation
+}
+
+// clockKey 当前时间函数的context key
+type clockKey struct{}
+
+// WithClock 设置相对时间规则使用的当前时间函数，优先于校验配置的Now
+func WithClock(ctx context.Context, now func() time.Time) context.Context {
+	return context.WithValue(ctx, clockKey{}, now)
+}
+
+// Now 相对时间规则使用的当前时间，依次取WithClock设置的函数、校验配置的Now、time.Now
+func Now(ctx context.Context) time.Time {
+	if ctx != nil {
+		if now, _ := ctx.Value(clockKey{}).(func() time.Time); now != nil {
+			return now()
+		}
+		if cfg := ConfigFrom(ctx); cfg != nil && cfg.Now != nil {
+			return cfg.Now()
+		}
+	}
+	return time.Now()
+}
+
+// Before 时间早于t，参数值替换为time.Time，字符串按DefaultTimeLayouts解析
+func Before(t time.Time, emsg ...string) Validator {
+	return timeRule(emsg, "before", map[string]interface{}{"time": formatTime(t)}, func(val time.Time, now func() time.Time, dateOnly bool) bool {
+		return val.Before(t)
+	})
+}
+
+// After 时间晚于t，参数值替换为time.Time，字符串按DefaultTimeLayouts解析
+func After(t time.Time, emsg ...string) Validator {
+	return timeRule(emsg, "after", map[string]interface{}{"time": formatTime(t)}, func(val time.Time, now func() time.Time, dateOnly bool) bool {
+		return val.After(t)
+	})
+}
+
+// NotInFuture 时间不晚于当前时间，日期不晚于今天
+func NotInFuture(emsg ...string) Validator {
+	return timeRule(emsg, "not_future", nil, func(val time.Time, now func() time.Time, dateOnly bool) bool {
+		if dateOnly {
+			return days(val) <= days(now())
+		}
+		return !val.After(now())
+	})
+}
+
+// NotInPast 时间不早于当前时间，日期不早于今天
+func NotInPast(emsg ...string) Validator {
+	return timeRule(emsg, "not_past", nil, func(val time.Time, now func() time.Time, dateOnly bool) bool {
+		if dateOnly {
+			return days(val) >= days(now())
+		}
+		return !val.Before(now())
+	})
+}
+
+// WithinLast 时间在当前时间之前的d以内 [now-d,now]，日期在今天及之前的d天以内
+func WithinLast(d time.Duration, emsg ...string) Validator {
+	return timeRule(emsg, "within_last", map[string]interface{}{"duration": formatDuration(d)}, func(val time.Time, now func() time.Time, dateOnly bool) bool {
+		n := now()
+		if dateOnly {
+			v, today := days(val), days(n)
+			return v <= today && v >= today-int64(d/(24*time.Hour))
+		}
+		return !val.After(n) && !val.Before(n.Add(-d))
+	})
+}
+
+// WithinNext 时间在当前时间之后的d以内 [now,now+d]，日期在今天及之后的d天以内
+// 如预约日期须在90天内 WithinNext(90*24*time.Hour)
+func WithinNext(d time.Duration, emsg ...string) Validator {
+	return timeRule(emsg, "within_next", map[string]interface{}{"duration": formatDuration(d)}, func(val time.Time, now func() time.Time, dateOnly bool) bool {
+		n := now()
+		if dateOnly {
+			v, today := days(val), days(n)
+			return v >= today && v <= today+int64(d/(24*time.Hour))
+		}
+		return !val.Before(n) && !val.After(n.Add(d))
+	})
+}
+
+// timeRule 时间规则，当前时间由Now获取
+// 参数值替换为time.Time，字符串按DefaultTimeLayouts及校验配置的时区解析
+// 参数为不含时分秒的日期（如 2024-03-01 或经Time("2006-01-02")解析）时dateOnly为true，
+// 当前时间转到参数值的时区后按天比较，否则按时间点比较
+func timeRule(emsg []string, key string, args map[string]interface{}, check func(val time.Time, now func() time.Time, dateOnly bool) bool) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		val, layout, ok := parseTime(opts, DefaultTimeLayouts, nil)
+		if !ok {
+			return FailMsg(emsg, "time", nil)
+		}
+		dateOnly := opts.dateOnly
+		if layout != "" {
+			dateOnly = !hasClock(layout)
+		}
+		now := func() time.Time {
+			return Now(opts.Ctx).In(val.Location())
+		}
+		if !check(val, now, dateOnly) {
+			return FailMsg(emsg, key, args)
+		}
+		opts.Value = val
+		opts.dateOnly = dateOnly
+		return Succ()
+	}
+}
+
+// formatTime 错误信息中的时间
+func formatTime(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// formatDuration 错误信息中的时长，整天时为天数，如 90d
+func formatDuration(d time.Duration) string {
+	day := 24 * time.Hour
+	if d >= day && d%day == 0 {
+		return strconv.FormatInt(int64(d/day), 10) + "d"
+	}
+	return d.String()
+}
@@ -0,0 +1,58 @@
+package validator
+
+import (
+	"github.com/rumis/govalidate/utils"
+)
+
+// intValue 按类型模式将参数值转为整数
+func intValue(opts *ValidateOptions) (int, bool) {
+	if opts.Strict() {
+		return utils.GetIntStrict(opts.Value)
+	}
+	return utils.GetIntValue(opts.Value)
+}
+
+// floatValue 按类型模式将参数值转为浮点
+func floatValue(opts *ValidateOptions) (float64, bool) {
+	if opts.Strict() {
+		return utils.GetFloatStrict(opts.Value)
+	}
+	return utils.GetFloatValue(opts.Value)
+}
+
+// stringValue 按类型模式将参数值转为字符串
+func stringValue(opts *ValidateOptions) (string, bool) {
+	if opts.Strict() {
+		return utils.GetStringStrict(opts.Value)
+	}
+	return utils.GetStringValue(opts.Value)
+}
+
+// booleanValue 按类型模式将参数值转为布尔值，宽松模式下使用校验配置的布尔值词表
+func booleanValue(opts *ValidateOptions) (bool, bool) {
+	if opts.Strict() {
+		return utils.GetBooleanStrict(opts.Value)
+	}
+	if opts.Ctx != nil {
+		if cfg := ConfigFrom(opts.Ctx); cfg != nil && cfg.BoolVocab != nil {
+			return cfg.BoolVocab.Parse(opts.Value)
+		}
+	}
+	return utils.GetBooleanValue(opts.Value)
+}
+
+// int64Value 按类型模式将参数值转为int64
+func int64Value(opts *ValidateOptions) (int64, bool) {
+	if opts.Strict() {
+		return utils.GetInt64Strict(opts.Value)
+	}
+	return utils.GetInt64Value(opts.Value)
+}
+
+// uint64Value 按类型模式将参数值转为uint64
+func uint64Value(opts *ValidateOptions) (uint64, bool) {
+	if opts.Strict() {
+		return utils.GetUint64Strict(opts.Value)
+	}
+	return utils.GetUint64Value(opts.Value)
+}
@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"github.com/rumis/govalidate/utils"
+)
+
+// UnionDefault 未匹配任何类型时使用的规则集KEY
+const UnionDefault = "*"
+
+// UnionVariantKey 使用UnionDefault规则集时，校验结果中记录实际应用类型的KEY，值为UnionDefault
+const UnionVariantKey = "_variant"
+
+// Union 按类型字段选择规则集，参数为对象
+// 类型字段的值在variants中选择规则集，未匹配时使用UnionDefault对应的规则集，均不存在时校验失败
+// 参数值替换为所选规则集的校验结果，结果中类型字段保持原值，使用默认规则集时结果中UnionVariantKey为UnionDefault
+func Union(typeKey string, variants map[string][]Filter, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		obj, ok := opts.Value.(map[string]interface{})
+		if !ok {
+			return FailMsg(emsg, "object", nil)
+		}
+		variant, rules, ok := unionVariant(obj, typeKey, variants)
+		if !ok {
+			return FailMsg(emsg, "union", map[string]interface{}{"type": variant})
+		}
+		res, ferr := ValidateFilters(WithPath(opts.Context(), opts.Path), obj, rules)
+		if ferr != nil {
+			return NestedFail(ferr)
+		}
+		unionResult(res, obj, typeKey, variant)
+		opts.Value = res
+		return Succ()
+	}
+}
+
+// UnionParams 按类型字段选择规则集，校验全部参数
+// 用于整个参数对象为多态结构的情况，所选规则集的校验结果合并到最终结果中
+// 结果中类型字段保持原值，使用默认规则集时结果中UnionVariantKey为UnionDefault
+func UnionParams(typeKey string, variants map[string][]Filter, emsg ...string) Validator {
+	return func(opts *ValidateOptions) ValidateResult {
+		variant, rules, ok := unionVariant(opts.Params, typeKey, variants)
+		if !ok {
+			return FailMsg(emsg, "union", map[string]interface{}{"type": variant})
+		}
+		res, ferr := ValidateFilters(opts.Context(), opts.Params, rules)
+		if ferr != nil {
+			return NestedFail(ferr)
+		}
+		unionResult(res, opts.Params, typeKey, variant)
+		if opts.Extend == nil {
+			opts.Extend = make(map[string]interface{}, len(res))
+		}
+		for k, v := range res {
+			opts.Extend[k] = v
+		}
+		opts.Key = "-"
+		return Succ()
+	}
+}
+
+// unionVariant 根据类型字段选择规则集，返回实际应用的类型，未匹配时返回类型字段的原值
+func unionVariant(obj map[string]interface{}, typeKey string, variants map[string][]Filter) (string, []Filter, bool) {
+	variant, _ := utils.GetStringValue(obj[typeKey])
+	if rules, ok := variants[variant]; ok && variant != UnionDefault {
+		return variant, rules, true
+	}
+	if rules, ok := variants[UnionDefault]; ok {
+		return UnionDefault, rules, true
+	}
+	return variant, nil, false
+}
+
+// unionResult 在校验结果中保留类型字段的原值，并记录使用了默认规则集
+func unionResult(res map[string]interface{}, obj map[string]interface{}, typeKey string, variant string) {
+	if val, ok := obj[typeKey]; ok {
+		res[typeKey] = val
+	}
+	if variant == UnionDefault {
+		res[UnionVariantKey] = UnionDefault
+	}
+}
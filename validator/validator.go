@@ -16,18 +16,13 @@ func Required(emsg ...string) Validator {
 		if opts.Value != nil {
 			return Succ()
 		}
-		return Fail(emsg)
+		return FailMsg(emsg, "required", nil)
 	}
 }
 
 // RequiredMultiLang 多语言
 func RequiredMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		if opts.Value != nil {
-			return Succ()
-		}
-		return FailMultiLang(emsg)
-	}
+	return MultiLang(Required(emsg...))
 }
 
 // Optional 参数可选，可设置默认值
@@ -47,9 +42,9 @@ func Optional(defaultVal ...interface{}) Validator {
 // Int 参数为整形
 func Int(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		v, ok := utils.GetIntValue(opts.Value)
+		v, ok := intValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "int", nil)
 		}
 		opts.Value = v
 		return Succ()
@@ -58,22 +53,15 @@ func Int(emsg ...string) Validator {
 
 // IntMultiLang 多语言参数为整形
 func IntMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		v, ok := utils.GetIntValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		opts.Value = v
-		return Succ()
-	}
+	return MultiLang(Int(emsg...))
 }
 
-// Float 浮点数
+// Float 浮点数，NaN及正负无穷校验失败
 func Float(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		v, ok := utils.GetFloatValue(opts.Value)
-		if !ok {
-			return Fail(emsg)
+		v, ok := floatValue(opts)
+		if !ok || !executor.Finite(v) {
+			return FailMsg(emsg, "float", nil)
 		}
 		opts.Value = v
 		return Succ()
@@ -82,22 +70,15 @@ func Float(emsg ...string) Validator {
 
 // FloatMultiLang 多语言浮点数
 func FloatMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		v, ok := utils.GetFloatValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		opts.Value = v
-		return Succ()
-	}
+	return MultiLang(Float(emsg...))
 }
 
 // String 类型为字符串
 func String(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		str, ok := utils.GetStringValue(opts.Value)
+		str, ok := stringValue(opts)
 		if !ok || len(str) == 0 {
-			return Fail(emsg)
+			return FailMsg(emsg, "string", nil)
 		}
 		opts.Value = str
 		return Succ()
@@ -106,14 +87,7 @@ func String(emsg ...string) Validator {
 
 // StringMultiLang 多语言-类型为字符串
 func StringMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		str, ok := utils.GetStringValue(opts.Value)
-		if !ok || len(str) == 0 {
-			return FailMultiLang(emsg)
-		}
-		opts.Value = str
-		return Succ()
-	}
+	return MultiLang(String(emsg...))
 }
 
 // EmptyString 空字符串，跳过后续校验规则
@@ -151,37 +125,48 @@ func ResetKey(newKey string) Validator {
 // Boolean 布尔值
 func Boolean(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		v, ok := utils.GetBooleanValue(opts.Value)
+		v, ok := booleanValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "boolean", nil)
 		}
 		opts.Value = v
 		return Succ()
 	}
 }
 
-// BooleanMultiLang 多语言布尔值
-func BooleanMultiLang(emsg ...string) Validator {
+// BooleanWith 按词表转为布尔值，如 utils.ExtBoolVocab 接受 on/off、yes/no、是/否、1/0
+// 严格模式下仅接受布尔类型
+func BooleanWith(vocab *utils.BoolVocab, emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		v, ok := utils.GetBooleanValue(opts.Value)
+		var v, ok bool
+		if opts.Strict() {
+			v, ok = utils.GetBooleanStrict(opts.Value)
+		} else {
+			v, ok = vocab.Parse(opts.Value)
+		}
 		if !ok {
-			return FailMultiLang(emsg)
+			return FailMsg(emsg, "boolean", nil)
 		}
 		opts.Value = v
 		return Succ()
 	}
 }
 
+// BooleanMultiLang 多语言布尔值
+func BooleanMultiLang(emsg ...string) Validator {
+	return MultiLang(Boolean(emsg...))
+}
+
 // Email 邮件
 func Email(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "email", nil)
 		}
 		ok = executor.Email(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "email", nil)
 		}
 		return Succ()
 	}
@@ -189,29 +174,19 @@ func Email(emsg ...string) Validator {
 
 // EmailMultiLang 邮件
 func EmailMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Email(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Email(emsg...))
 }
 
 // Url URL链接
 func Url(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "url", nil)
 		}
 		ok = executor.Url(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "url", nil)
 		}
 		return Succ()
 	}
@@ -219,29 +194,19 @@ func Url(emsg ...string) Validator {
 
 // UrlMultiLang 多语言-URL链接
 func UrlMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Url(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Url(emsg...))
 }
 
 // Phone 手机号码
 func Phone(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "phone", nil)
 		}
 		ok = executor.Phone(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "phone", nil)
 		}
 		return Succ()
 	}
@@ -249,29 +214,19 @@ func Phone(emsg ...string) Validator {
 
 // PhoneMultiLang 多语言 手机号码
 func PhoneMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Phone(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Phone(emsg...))
 }
 
 // Ipv4 ip地址，v4格式
 func Ipv4(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "ipv4", nil)
 		}
 		ok = executor.Ipv4(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "ipv4", nil)
 		}
 		return Succ()
 	}
@@ -279,29 +234,19 @@ func Ipv4(emsg ...string) Validator {
 
 // Ipv4MultiLang ip地址，v4格式 多语言支持
 func Ipv4MultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Ipv4(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Ipv4(emsg...))
 }
 
 // Date 日期，格式： 2006-01-02
 func Date(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "date", nil)
 		}
 		ok = executor.Date(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "date", nil)
 		}
 		return Succ()
 	}
@@ -309,29 +254,19 @@ func Date(emsg ...string) Validator {
 
 // DateMultiLang 日期，格式： 2006-01-02 多语言支持
 func DateMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Date(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Date(emsg...))
 }
 
 // Datetime 时间，格式：2006-01-02 15:04:05
 func Datetime(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "datetime", nil)
 		}
 		ok = executor.Datetime(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "datetime", nil)
 		}
 		return Succ()
 	}
@@ -339,29 +274,19 @@ func Datetime(emsg ...string) Validator {
 
 // DatetimeMultiLang  多语言 时间，格式：2006-01-02 15:04:05
 func DatetimeMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Datetime(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Datetime(emsg...))
 }
 
-// DatetimeRFC3339 时间，格式: 2006-01-02T15:04:05Z
+// DatetimeRFC3339 时间，格式: 2006-01-02T15:04:05Z07:00，支持小数秒
 func DatetimeRFC3339(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "rfc3339", nil)
 		}
 		ok = executor.DatetimeRFC3339(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "rfc3339", nil)
 		}
 		return Succ()
 	}
@@ -370,13 +295,13 @@ func DatetimeRFC3339(emsg ...string) Validator {
 // Length 字符串字符长度限制 [min,max]
 func Length(min int, max int, emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "length", map[string]interface{}{"min": min, "max": max})
 		}
 		ok = executor.Length(min, max)(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "length", map[string]interface{}{"min": min, "max": max})
 		}
 		return Succ()
 	}
@@ -384,29 +309,19 @@ func Length(min int, max int, emsg ...string) Validator {
 
 // LengthMultiLang 字符串字符长度限制 [min,max]
 func LengthMultiLang(min int, max int, emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Length(min, max)(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Length(min, max, emsg...))
 }
 
 // Between 数字值范围限制 [min,max]
 func Between(min int, max int, emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetIntValue(opts.Value)
+		val, ok := intValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "between", map[string]interface{}{"min": min, "max": max})
 		}
 		ok = executor.Between(min, max)(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "between", map[string]interface{}{"min": min, "max": max})
 		}
 		return Succ()
 	}
@@ -414,29 +329,19 @@ func Between(min int, max int, emsg ...string) Validator {
 
 // BetweenMultiLang 数字值范围限制 [min,max] - 多语言支持
 func BetweenMultiLang(min int, max int, emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetIntValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Between(min, max)(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Between(min, max, emsg...))
 }
 
 // EnumInt 枚举，值类型为整形
 func EnumInt(enums []int, emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetIntValue(opts.Value)
+		val, ok := intValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "enum", map[string]interface{}{"enum": enums})
 		}
 		ok = executor.EnumInt(enums)(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "enum", map[string]interface{}{"enum": enums})
 		}
 		opts.Value = val
 		return Succ()
@@ -445,30 +350,19 @@ func EnumInt(enums []int, emsg ...string) Validator {
 
 // EnumIntMultiLang 枚举，值类型为整形
 func EnumIntMultiLang(enums []int, emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetIntValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.EnumInt(enums)(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		opts.Value = val
-		return Succ()
-	}
+	return MultiLang(EnumInt(enums, emsg...))
 }
 
 // EnumString 枚举，值类型为字符串
 func EnumString(enums []string, emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "enum", map[string]interface{}{"enum": enums})
 		}
 		ok = executor.EnumString(enums)(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "enum", map[string]interface{}{"enum": enums})
 		}
 		opts.Value = val
 		return Succ()
@@ -477,30 +371,19 @@ func EnumString(enums []string, emsg ...string) Validator {
 
 // EnumStringMultiLang 多语言版本 枚举，值类型为字符串
 func EnumStringMultiLang(enums []string, emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.EnumString(enums)(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		opts.Value = val
-		return Succ()
-	}
+	return MultiLang(EnumString(enums, emsg...))
 }
 
 // DotInt 英文逗号分隔的整数
 func DotInt(emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "dotint", nil)
 		}
 		ok = executor.DotInt(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "dotint", nil)
 		}
 		return Succ()
 	}
@@ -508,29 +391,19 @@ func DotInt(emsg ...string) Validator {
 
 // DotIntMultiLang 多语言支持 英文逗号分隔的整数
 func DotIntMultiLang(emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.DotInt(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(DotInt(emsg...))
 }
 
 // Maxdot 逗号分隔的ID支持的最多ID个数
 func Maxdot(max int, emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "maxdot", map[string]interface{}{"max": max})
 		}
 		dotCnt := strings.Count(val, ",")
 		if dotCnt+1 > max {
-			return Fail(emsg)
+			return FailMsg(emsg, "maxdot", map[string]interface{}{"max": max})
 		}
 		return Succ()
 	}
@@ -538,17 +411,7 @@ func Maxdot(max int, emsg ...string) Validator {
 
 // MaxdotMultiLang 多语言支持 逗号分隔的ID支持的最多ID个数
 func MaxdotMultiLang(max int, emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		dotCnt := strings.Count(val, ",")
-		if dotCnt+1 > max {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Maxdot(max, emsg...))
 }
 
 // Dotint2Slice 逗号分隔的ID字符串转为数组
@@ -607,13 +470,13 @@ func DotToSlice() Validator {
 // Regex 正则表达式
 func Regex(reg string, emsg ...string) Validator {
 	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
+		val, ok := stringValue(opts)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "regex", nil)
 		}
 		ok = executor.Regex(reg)(val)
 		if !ok {
-			return Fail(emsg)
+			return FailMsg(emsg, "regex", nil)
 		}
 		return Succ()
 	}
@@ -621,17 +484,7 @@ func Regex(reg string, emsg ...string) Validator {
 
 // Regex 正则表达式
 func RegexMultiLang(reg string, emsg ...string) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		val, ok := utils.GetStringValue(opts.Value)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		ok = executor.Regex(reg)(val)
-		if !ok {
-			return FailMultiLang(emsg)
-		}
-		return Succ()
-	}
+	return MultiLang(Regex(reg, emsg...))
 }
 
 // Paginate 处理分页信息
@@ -689,7 +542,7 @@ func IntSlice(msgExecutor ...interface{}) Validator {
 		case 2:
 			errMsg, ok := msgExecutor[0].(string)
 			if !ok {
-				return Fail(errMsgs)
+				return FailMsg(errMsgs, "intslice", nil)
 			}
 			errMsgs = append(errMsgs, errMsg)
 			switch p := msgExecutor[1].(type) {
@@ -701,14 +554,14 @@ func IntSlice(msgExecutor ...interface{}) Validator {
 		}
 		vals, ok := utils.GetIntSlice(opts.Value)
 		if !ok {
-			return Fail(errMsgs)
+			return FailMsg(errMsgs, "intslice", nil)
 		}
 		if len(execs) > 0 {
 			for _, exe := range execs {
 				for _, val := range vals {
 					ok = exe(val)
 					if !ok {
-						return Fail(errMsgs)
+						return FailMsg(errMsgs, "intslice", nil)
 					}
 				}
 			}
@@ -718,57 +571,9 @@ func IntSlice(msgExecutor ...interface{}) Validator {
 	}
 }
 
-// IntSliceMultiLang 整形数组
-// 一个参数：可以是【错误信息】或者是【单个要素的校验条件】，校验条件可为单个或数组
-// 两个参数：第一个参数一定为【错误信息】，第二个参数为【单个要素的校验条件】，校验条件可为单个或数组
+// IntSliceMultiLang 多语言 整形数组，参数同IntSlice
 func IntSliceMultiLang(msgExecutor ...interface{}) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		errMsgs := make([]string, 0)
-		execs := make([]executor.IntExecutor, 0)
-		paramLen := len(msgExecutor)
-
-		switch paramLen {
-		case 0:
-			// nothing to do
-		case 1:
-			switch p := msgExecutor[0].(type) {
-			case string:
-				errMsgs = append(errMsgs, p)
-			case executor.IntExecutor:
-				execs = append(execs, p)
-			case []executor.IntExecutor:
-				execs = append(execs, p...)
-			}
-		case 2:
-			errMsg, ok := msgExecutor[0].(string)
-			if !ok {
-				return FailMultiLang(errMsgs)
-			}
-			errMsgs = append(errMsgs, errMsg)
-			switch p := msgExecutor[1].(type) {
-			case executor.IntExecutor:
-				execs = append(execs, p)
-			case []executor.IntExecutor:
-				execs = append(execs, p...)
-			}
-		}
-		vals, ok := utils.GetIntSlice(opts.Value)
-		if !ok {
-			return FailMultiLang(errMsgs)
-		}
-		if len(execs) > 0 {
-			for _, exe := range execs {
-				for _, val := range vals {
-					ok = exe(val)
-					if !ok {
-						return FailMultiLang(errMsgs)
-					}
-				}
-			}
-		}
-		opts.Value = vals
-		return Succ()
-	}
+	return MultiLang(IntSlice(msgExecutor...))
 }
 
 // StringSlice 字符串数组
@@ -795,7 +600,7 @@ func StringSlice(msgExecutor ...interface{}) Validator {
 		case 2:
 			errMsg, ok := msgExecutor[0].(string)
 			if !ok {
-				return Fail(errMsgs)
+				return FailMsg(errMsgs, "stringslice", nil)
 			}
 			errMsgs = append(errMsgs, errMsg)
 			switch p := msgExecutor[1].(type) {
@@ -807,14 +612,14 @@ func StringSlice(msgExecutor ...interface{}) Validator {
 		}
 		vals, ok := utils.GetStringSlice(opts.Value)
 		if !ok {
-			return Fail(errMsgs)
+			return FailMsg(errMsgs, "stringslice", nil)
 		}
 		if len(execs) > 0 {
 			for _, exe := range execs {
 				for _, val := range vals {
 					ok = exe(val)
 					if !ok {
-						return Fail(errMsgs)
+						return FailMsg(errMsgs, "stringslice", nil)
 					}
 				}
 			}
@@ -824,57 +629,9 @@ func StringSlice(msgExecutor ...interface{}) Validator {
 	}
 }
 
-// StringSliceMultiLang 字符串数组
-// 一个参数：可以是【错误信息】或者是【单个要素的校验条件】，校验条件可为单个或数组
-// 两个参数：第一个参数一定为【错误信息】，第二个参数为【单个要素的校验条件】，校验条件可为单个或数组
+// StringSliceMultiLang 多语言 字符串数组，参数同StringSlice
 func StringSliceMultiLang(msgExecutor ...interface{}) Validator {
-	return func(opts *ValidateOptions) ValidateResult {
-		errMsgs := make([]string, 0)
-		execs := make([]executor.StringExecutor, 0)
-		paramLen := len(msgExecutor)
-
-		switch paramLen {
-		case 0:
-			// nothing to do
-		case 1:
-			switch p := msgExecutor[0].(type) {
-			case string:
-				errMsgs = append(errMsgs, p)
-			case executor.StringExecutor:
-				execs = append(execs, p)
-			case []executor.StringExecutor:
-				execs = append(execs, p...)
-			}
-		case 2:
-			errMsg, ok := msgExecutor[0].(string)
-			if !ok {
-				return FailMultiLang(errMsgs)
-			}
-			errMsgs = append(errMsgs, errMsg)
-			switch p := msgExecutor[1].(type) {
-			case executor.StringExecutor:
-				execs = append(execs, p)
-			case []executor.StringExecutor:
-				execs = append(execs, p...)
-			}
-		}
-		vals, ok := utils.GetStringSlice(opts.Value)
-		if !ok {
-			return FailMultiLang(errMsgs)
-		}
-		if len(execs) > 0 {
-			for _, exe := range execs {
-				for _, val := range vals {
-					ok = exe(val)
-					if !ok {
-						return FailMultiLang(errMsgs)
-					}
-				}
-			}
-		}
-		opts.Value = vals
-		return Succ()
-	}
+	return MultiLang(StringSlice(msgExecutor...))
 }
 
 // RemoveEmoji 删除表情符号
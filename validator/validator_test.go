@@ -1,8 +1,17 @@
 package validator
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"reflect"
 	"testing"
+	"time"
+
+	"github.com/rumis/govalidate/executor"
 )
 
 func TestXSS(t *testing.T) {
@@ -20,3 +29,388 @@ func TestXSS(t *testing.T) {
 	fmt.Println(opt)
 	fmt.Println(opt1)
 }
+
+type langLocalizer string
+
+func (l langLocalizer) Localize(id string) string {
+	return id
+}
+
+func (l langLocalizer) Language() string {
+	return string(l)
+}
+
+func TestDefaultMessages(t *testing.T) {
+	rules := []Filter{
+		NewNormalFilter("age", []Validator{Required(), Between(1, 120)}, "", 0),
+		NewNormalFilter("sex", []Validator{Required(), EnumString([]string{"man", "woman"})}, "", 0),
+		NewNormalFilter("name", []Validator{Required()}, "", 0),
+	}
+	params := map[string]interface{}{"age": 130, "sex": "x"}
+	cases := []struct {
+		ctx    context.Context
+		expect []string
+	}{
+		{context.Background(), []string{"age must be between 1 and 120", "sex must be one of man, woman", "name is required"}},
+		{WithLang(context.Background(), "zh-CN"), []string{"age必须在1到120之间", "sex必须为以下值之一：man, woman", "name不能为空"}},
+		{context.WithValue(context.Background(), GetLocalizerKey(), langLocalizer("zh")), []string{"age必须在1到120之间", "sex必须为以下值之一：man, woman", "name不能为空"}},
+		{WithConfig(context.Background(), &Config{Lang: "zh-CN", DefaultMsg: "%s error"}), []string{"age error", "sex error", "name error"}},
+	}
+	for _, c := range cases {
+		for idx, filter := range rules {
+			ferr := ValidateFilter(c.ctx, filter, params, map[string]interface{}{})
+			if ferr == nil || ferr.Msg != c.expect[idx] {
+				t.Fatalf("expect %s, got %v", c.expect[idx], ferr)
+			}
+		}
+	}
+
+	// 自定义错误信息原样返回，多语言模式下翻译后替换占位符
+	plain := NewNormalFilter("name", []Validator{Required()}, "name must match {field}", 0)
+	if ferr := ValidateFilter(context.Background(), plain, params, map[string]interface{}{}); ferr == nil || ferr.Msg != "name must match {field}" {
+		t.Fatal(ferr)
+	}
+	ctx := context.WithValue(context.Background(), GetLocalizerKey(), langLocalizer("en"))
+	localized := NewMultiLangFilter("name", []Validator{Required()}, "{field} is missing", 0)
+	if ferr := ValidateFilter(ctx, localized, params, map[string]interface{}{}); ferr == nil || ferr.Msg != "name is missing" {
+		t.Fatal(ferr)
+	}
+}
+
+func TestWideInt(t *testing.T) {
+	cases := []struct {
+		rule   Validator
+		val    interface{}
+		ok     bool
+		expect interface{}
+	}{
+		{Int64(), "9007199254740993", true, int64(9007199254740993)},
+		{Int64(), json.Number("1234567890123456789"), true, int64(1234567890123456789)},
+		{Int64(), "9223372036854775808", false, nil},
+		{Int64(), uint64(math.MaxUint64), false, nil},
+		{Uint64(), "18446744073709551615", true, uint64(math.MaxUint64)},
+		{Uint64(), -1, false, nil},
+		{Int32(), int64(math.MaxInt32) + 1, false, nil},
+		{Int32(), "-2147483648", true, int32(math.MinInt32)},
+		{Uint32(), "4294967296", false, nil},
+		{Uint32(), json.Number("4294967295"), true, uint32(math.MaxUint32)},
+		{Between64(1, 1<<40, "id"), "1099511627776", true, int64(1 << 40)},
+		{Between64(1, 1<<40, "id"), "1099511627777", false, nil},
+		{BetweenUint64(10, math.MaxUint64), "18446744073709551615", true, uint64(math.MaxUint64)},
+		{EnumInt64([]int64{1 << 33}), 1 << 33, true, int64(1 << 33)},
+		{Int64Slice(executor.Between64(0, math.MaxInt64)), []interface{}{"1", json.Number("9223372036854775807")}, true, []int64{1, math.MaxInt64}},
+		{Int64Slice(executor.Between64(0, math.MaxInt64)), []string{"1", "-1"}, false, nil},
+		{Int(), uint64(math.MaxUint64), false, nil},
+	}
+	for idx, c := range cases {
+		opts := &ValidateOptions{Value: c.val}
+		res := c.rule(opts)
+		if (res.Stat(context.Background()) == VS_SUCCESS) != c.ok {
+			t.Fatalf("case %d: %v", idx, res)
+		}
+		if c.ok && !reflect.DeepEqual(opts.Value, c.expect) {
+			t.Fatalf("case %d: expect %v(%T), got %v(%T)", idx, c.expect, c.expect, opts.Value, opts.Value)
+		}
+	}
+}
+
+func TestStrictStringRules(t *testing.T) {
+	cases := []struct {
+		rule   Validator
+		val    interface{}
+		coerce bool
+		strict bool
+	}{
+		{Length(1, 5), 123, true, false},
+		{Length(1, 5), "123", true, true},
+		{Regex("^[0-9]+$"), 123, true, false},
+		{DotInt(), 12, true, false},
+		{DotInt(), "1,2", true, true},
+		{Maxdot(2), 12, true, false},
+		{Phone(), int64(13800138000), true, false},
+		{Phone(), "13800138000", true, true},
+		{Email(), "a@b.com", true, true},
+		{Url(), "https://example.com", true, true},
+		{Ipv4(), "127.0.0.1", true, true},
+		{Date(), "2024-03-01", true, true},
+		{Datetime(), "2024-03-01 12:00:00", true, true},
+		{DatetimeRFC3339(), "2024-03-01T12:00:00Z", true, true},
+	}
+	strict := WithConfig(context.Background(), &Config{TypeMode: TM_STRICT})
+	for idx, c := range cases {
+		for ctx, ok := range map[context.Context]bool{context.Background(): c.coerce, strict: c.strict} {
+			res := c.rule(&ValidateOptions{Value: c.val, Ctx: ctx})
+			if (res.Stat(ctx) == VS_SUCCESS) != ok {
+				t.Fatalf("case %d: strict %v, %v", idx, ctx == strict, res)
+			}
+		}
+	}
+}
+
+func TestFloatRules(t *testing.T) {
+	cases := []struct {
+		rule Validator
+		val  interface{}
+		ok   bool
+	}{
+		{Float(), "NaN", false},
+		{Float(), math.Inf(1), false},
+		{FloatBetween(-90, 90), "45.5", true},
+		{FloatBetween(-90, 90), 90.0001, false},
+		{Min(0.01), 0.01, true},
+		{Min(0.01), json.Number("0.009"), false},
+		{Max(100), 100, true},
+		{Max(100), math.NaN(), false},
+		{Positive(), 0, false},
+		{NonNegative(), 0, true},
+		{MultipleOf(0.01), "19.99", true},
+		{MultipleOf(0.05), 0.3, true},
+		{MultipleOf(0.05), 0.33, false},
+		{MultipleOf(0.01), 5000000.005, false},
+		{MultipleOf(0.01), 5000000.01, true},
+		{MultipleOf(1), 1e10 + 0.4, false},
+		{MultipleOf(1), 1e15, true},
+		{MultipleOf(0.1), 123456789.3, true},
+		{MaxDecimals(2), "19.99", true},
+		{MaxDecimals(2), "19.999", false},
+		{FloatSlice(executor.FloatBetween(-180, 180)), []interface{}{"120.5", 30.25}, true},
+		{FloatSlice(executor.FloatBetween(-180, 180)), []float64{181}, false},
+		{FloatSlice(), []string{"1", "Inf"}, false},
+	}
+	for idx, c := range cases {
+		res := c.rule(&ValidateOptions{Value: c.val})
+		if (res.Stat(context.Background()) == VS_SUCCESS) != c.ok {
+			t.Fatalf("case %d: %v", idx, res)
+		}
+	}
+
+	// 浮点规则不改变前序规则的结果类型
+	opts := &ValidateOptions{Value: "9007199254740993"}
+	if Int64()(opts).Stat(context.Background()) != VS_SUCCESS || Positive()(opts).Stat(context.Background()) != VS_SUCCESS || opts.Value != int64(9007199254740993) {
+		t.Fatalf("%v(%T)", opts.Value, opts.Value)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	cases := []struct {
+		rule   Validator
+		val    interface{}
+		ok     bool
+		expect interface{}
+	}{
+		{Decimal(DecimalOptions{}), "19.990", true, "19.99"},
+		{Decimal(DecimalOptions{}), json.Number("1.5e3"), true, "1500"},
+		{Decimal(DecimalOptions{}), "12345678901234567890.123456789", true, "12345678901234567890.123456789"},
+		{Decimal(DecimalOptions{}), "1/3", false, nil},
+		{Decimal(DecimalOptions{}), "abc", false, nil},
+		{Decimal(DecimalOptions{Precision: 5, Scale: 2}), "999.99", true, "999.99"},
+		{Decimal(DecimalOptions{Precision: 5, Scale: 2}), "1000", false, nil},
+		{Decimal(DecimalOptions{Precision: 5, Scale: 2}), "1.001", false, nil},
+		{Decimal(DecimalOptions{Scale: 2}), "-0.5", true, "-0.50"},
+		{Decimal(DecimalOptions{Min: "0.01", Max: "100"}), "0.001", false, nil},
+		{Decimal(DecimalOptions{Min: "0.01", Max: "100"}), 100, true, "100"},
+		{Decimal(DecimalOptions{Currency: "USD"}), 19.9, true, "19.90"},
+		{Money("CNY"), "19.99", true, int64(1999)},
+		{Money("JPY"), "1999", true, int64(1999)},
+		{Money("JPY"), "19.5", false, nil},
+		{Money("KWD"), json.Number("1.234"), true, int64(1234)},
+		{Money("CNY"), "92233720368547758.08", false, nil},
+		{Money("XXX"), "1", false, nil},
+		{Decimal(DecimalOptions{Output: DO_RAT}), "0.1", true, big.NewRat(1, 10)},
+	}
+	for idx, c := range cases {
+		opts := &ValidateOptions{Value: c.val}
+		res := c.rule(opts)
+		if (res.Stat(context.Background()) == VS_SUCCESS) != c.ok {
+			t.Fatalf("case %d: %v", idx, res)
+		}
+		if c.ok && !reflect.DeepEqual(opts.Value, c.expect) {
+			t.Fatalf("case %d: expect %v(%T), got %v(%T)", idx, c.expect, c.expect, opts.Value, opts.Value)
+		}
+	}
+
+	// 配置错误与参数错误的信息不同
+	configs := []struct {
+		rule   Validator
+		expect string
+	}{
+		{Money("XXX", "金额错误"), "amount has a misconfigured rule: unknown currency XXX"},
+		{Decimal(DecimalOptions{Min: "1.x"}), "amount has a misconfigured rule: invalid min 1.x"},
+		{Decimal(DecimalOptions{Output: DO_MINOR_UNITS}), "amount has a misconfigured rule: minor units output requires Scale or Currency"},
+		{Decimal(DecimalOptions{}), "amount must be a valid decimal number"},
+	}
+	for _, c := range configs {
+		ferr := ValidateFilter(context.Background(), NewNormalFilter("amount", []Validator{c.rule}, "", 0), map[string]interface{}{"amount": "abc"}, map[string]interface{}{})
+		if ferr == nil || ferr.Msg != c.expect {
+			t.Fatalf("expect %s, got %v", c.expect, ferr)
+		}
+	}
+}
+
+type orderStatus int8
+
+func TestAs(t *testing.T) {
+	RegisterParser(orderStatus(0), func(str string) (interface{}, error) {
+		switch str {
+		case "paid":
+			return orderStatus(1), nil
+		case "shipped":
+			return orderStatus(2), nil
+		}
+		return nil, fmt.Errorf("unknown status %s", str)
+	})
+	cases := []struct {
+		rule   Validator
+		val    interface{}
+		ok     bool
+		expect interface{}
+	}{
+		{As(net.IP{}), "127.0.0.1", true, net.ParseIP("127.0.0.1")},
+		{As(net.IP{}), "localhost", false, nil},
+		{As(new(big.Int)), "123456789012345678901234567890", true, func() *big.Int { v, _ := new(big.Int).SetString("123456789012345678901234567890", 10); return v }()},
+		{As(orderStatus(0)), "shipped", true, orderStatus(2)},
+		{As(new(orderStatus)), "paid", true, func() *orderStatus { s := orderStatus(1); return &s }()},
+		{As(orderStatus(0)), "lost", false, nil},
+		{As(orderStatus(0)), orderStatus(1), true, orderStatus(1)},
+		{As(1), "1", false, nil},
+	}
+	for idx, c := range cases {
+		opts := &ValidateOptions{Value: c.val}
+		res := c.rule(opts)
+		if (res.Stat(context.Background()) == VS_SUCCESS) != c.ok {
+			t.Fatalf("case %d: %v", idx, res)
+		}
+		if c.ok && !reflect.DeepEqual(opts.Value, c.expect) {
+			t.Fatalf("case %d: expect %v(%T), got %v(%T)", idx, c.expect, c.expect, opts.Value, opts.Value)
+		}
+	}
+
+	msgs := []struct {
+		ctx    context.Context
+		expect string
+	}{
+		{context.Background(), "ip is not a valid net.IP"},
+		{WithLang(context.Background(), "zh-CN"), "ip不是有效的net.IP"},
+	}
+	for _, c := range msgs {
+		ferr := ValidateFilter(c.ctx, NewNormalFilter("ip", []Validator{As(net.IP{})}, "", 0), map[string]interface{}{"ip": "localhost"}, map[string]interface{}{})
+		if ferr == nil || ferr.Msg != c.expect {
+			t.Fatalf("expect %s, got %v", c.expect, ferr)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	utc8 := time.FixedZone("UTC+8", 8*60*60)
+	cases := []struct {
+		rule   Validator
+		val    interface{}
+		ok     bool
+		expect time.Time
+	}{
+		{Time(), "2024-03-01T10:00:00+08:00", true, time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)},
+		{Time(), "2024-03-01T10:00:00.123Z", true, time.Date(2024, 3, 1, 10, 0, 0, 123000000, time.UTC)},
+		{Time(), "2024-03-01 10:00:00", true, time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)},
+		{Time(), "2024-03-01", true, time.Date(2024, 2, 29, 16, 0, 0, 0, time.UTC)},
+		{Time(), "2024-02-30", false, time.Time{}},
+		{Time("2006/01/02 15:04"), "2024/03/01 10:00", true, time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)},
+		{Time("2006/01/02"), "2024-03-01", false, time.Time{}},
+		{TimeWith(TimeOptions{Location: time.UTC}), "2024-03-01 10:00:00", true, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{Time(), time.Date(2024, 3, 1, 10, 0, 0, 0, utc8), true, time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)},
+		{Time(), 20240301, false, time.Time{}},
+	}
+	for idx, c := range cases {
+		opts := &ValidateOptions{Value: c.val}
+		res := c.rule(opts)
+		if (res.Stat(context.Background()) == VS_SUCCESS) != c.ok {
+			t.Fatalf("case %d: %v", idx, res)
+		}
+		if c.ok && !opts.Value.(time.Time).Equal(c.expect) {
+			t.Fatalf("case %d: expect %v, got %v", idx, c.expect, opts.Value)
+		}
+	}
+
+	// 校验配置的时区
+	ctx := WithConfig(context.Background(), &Config{Location: time.UTC})
+	opts := &ValidateOptions{Value: "2024-03-01 10:00:00", Ctx: ctx}
+	if res := Time()(opts); res.Stat(ctx) != VS_SUCCESS || opts.Value.(time.Time).Location() != time.UTC {
+		t.Fatal(res, opts.Value)
+	}
+	if !executor.DatetimeRFC3339("2024-03-01T10:00:00.5+08:00") || executor.DatetimeRFC3339("2024-03-01 10:00:00") {
+		t.Fatal("rfc3339")
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := WithClock(context.Background(), func() time.Time { return now })
+	day := 24 * time.Hour
+	cases := []struct {
+		rule Validator
+		val  interface{}
+		ok   bool
+	}{
+		{Before(now), now.Add(-time.Second), true},
+		{Before(now), now, false},
+		{After(now), "2024-03-01T12:00:01Z", true},
+		{After(now), "2024-03-01T12:00:00Z", false},
+		{After(now), "x", false},
+		{NotInFuture(), now, true},
+		{NotInFuture(), now.Add(time.Nanosecond), false},
+		{NotInPast(), now, true},
+		{NotInPast(), now.Add(-time.Nanosecond), false},
+		{WithinLast(7 * day), now.Add(-7 * day), true},
+		{WithinLast(7 * day), now.Add(-8 * day), false},
+		{WithinLast(7 * day), now.Add(time.Second), false},
+		{WithinNext(90 * day), now.Add(90 * day), true},
+		{WithinNext(90 * day), now.Add(91 * day), false},
+		{WithinNext(90 * day), now.Add(-time.Second), false},
+		// 日期按天比较，今天满足NotInPast、WithinNext
+		{NotInPast(), "2024-03-01", true},
+		{NotInPast(), "2024-02-29", false},
+		{NotInFuture(), "2024-03-01", true},
+		{NotInFuture(), "2024-03-02", false},
+		{WithinNext(90 * day), "2024-03-01", true},
+		{WithinNext(90 * day), "2024-05-30", true},
+		{WithinNext(90 * day), "2024-05-31", false},
+		{WithinLast(7 * day), "2024-03-01", true},
+		{WithinLast(7 * day), "2024-02-23", true},
+		{WithinLast(7 * day), "2024-02-22", false},
+		{WithinNext(90 * day), "2024-03-01 11:59:59", false},
+	}
+	for idx, c := range cases {
+		opts := &ValidateOptions{Value: c.val, Ctx: ctx}
+		res := c.rule(opts)
+		if (res.Stat(ctx) == VS_SUCCESS) != c.ok {
+			t.Fatalf("case %d: %v", idx, res)
+		}
+		if c.ok {
+			if _, ok := opts.Value.(time.Time); !ok {
+				t.Fatalf("case %d: %T", idx, opts.Value)
+			}
+		}
+	}
+
+	// Time规则解析的日期同样按天比较，当前时间转到参数值的时区
+	cfgUTC := WithClock(WithConfig(context.Background(), &Config{Location: time.UTC}), func() time.Time { return now })
+	opts := &ValidateOptions{Value: "2024-03-01", Ctx: cfgUTC}
+	if Time("2006-01-02")(opts).Stat(cfgUTC) != VS_SUCCESS || NotInPast()(opts).Stat(cfgUTC) != VS_SUCCESS {
+		t.Fatal("today")
+	}
+	late := WithClock(context.Background(), func() time.Time { return time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC) })
+	if res := NotInPast()(&ValidateOptions{Value: "2024-03-01", Ctx: late}); res.Stat(late) == VS_SUCCESS {
+		t.Fatal("2024-03-01 is yesterday in Asia/Shanghai")
+	}
+
+	// 校验配置的当前时间，WithClock优先
+	cfgCtx := WithConfig(context.Background(), &Config{Now: func() time.Time { return now.Add(-10 * day) }})
+	if res := NotInPast()(&ValidateOptions{Value: now.Add(-5 * day), Ctx: cfgCtx}); res.Stat(cfgCtx) != VS_SUCCESS {
+		t.Fatal(res)
+	}
+	if !Now(WithClock(cfgCtx, func() time.Time { return now })).Equal(now) {
+		t.Fatal("clock")
+	}
+	if formatDuration(90*day) != "90d" || formatDuration(90*time.Minute) != "1h30m0s" {
+		t.Fatal(formatDuration(90 * day))
+	}
+}